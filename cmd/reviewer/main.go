@@ -9,12 +9,17 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	// the image has no timezone database, it is needed for working hours of users
+	_ "time/tzdata"
 
 	"github.com/437d5/pr-review-manager/internal/application/http/handlers"
 	"github.com/437d5/pr-review-manager/internal/application/routers"
+	"github.com/437d5/pr-review-manager/internal/domain/models"
 	"github.com/437d5/pr-review-manager/internal/domain/repositories"
 	"github.com/437d5/pr-review-manager/internal/domain/services"
+	"github.com/437d5/pr-review-manager/internal/infrastructure/codehost"
 	"github.com/437d5/pr-review-manager/internal/infrastructure/db"
+	"github.com/437d5/pr-review-manager/internal/infrastructure/webhook"
 	"github.com/437d5/pr-review-manager/pkg/config"
 	"github.com/437d5/pr-review-manager/pkg/logger"
 	"github.com/jmoiron/sqlx"
@@ -60,10 +65,21 @@ func main() {
 	prService := services.NewPRService(uowFactory)
 	prHandler := handlers.NewPRHandler(prService)
 
+	statsService := services.NewStatsService(uowFactory)
+	statsHandler := handlers.NewStatsHandler(statsService)
+
+	webhookHandler := handlers.NewWebhookHandler(
+		prService,
+		cfg.Webhook.GitHubSecret,
+		cfg.Webhook.GitLabToken,
+	)
+
 	router := routers.InitRouter(
 		teamHandler,
 		userHandler,
 		prHandler,
+		statsHandler,
+		webhookHandler,
 	)
 
 	server := &http.Server{
@@ -77,6 +93,42 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	dispatcherCfg := services.DefaultDispatcherConfig(models.ConsumerWebhooks)
+	dispatcherCfg.PollInterval = time.Duration(cfg.Webhook.PollInterval) * time.Second
+	dispatcherCfg.MaxAttempts = cfg.Webhook.MaxAttempts
+	publisher := webhook.NewPublisher(
+		cfg.Webhook.URLs,
+		cfg.Webhook.Secret,
+		time.Duration(cfg.Webhook.Timeout)*time.Second,
+	)
+	dispatcher := services.NewOutboxDispatcher(uowFactory, publisher, dispatcherCfg)
+	go dispatcher.Run(ctx)
+
+	// without a token assignments are not written back to the code host,
+	// so its messages are not put to the outbox at all
+	if cfg.CodeHost.GitHubToken == "" {
+		teamService.SetOutboxConsumers(models.ConsumerWebhooks)
+		userService.SetOutboxConsumers(models.ConsumerWebhooks)
+		prService.SetOutboxConsumers(models.ConsumerWebhooks)
+	} else {
+		githubCfg := codehost.DefaultGitHubConfig(cfg.CodeHost.GitHubAPIURL, cfg.CodeHost.GitHubToken)
+		githubCfg.Timeout = time.Duration(cfg.CodeHost.Timeout) * time.Second
+		codeHostCfg := services.DefaultDispatcherConfig(models.ConsumerCodeHost)
+		codeHostCfg.PollInterval = dispatcherCfg.PollInterval
+		codeHostCfg.MaxAttempts = dispatcherCfg.MaxAttempts
+		codeHostDispatcher := services.NewOutboxDispatcher(
+			uowFactory,
+			services.NewCodeHostPublisher(codehost.NewGitHubClient(githubCfg)),
+			codeHostCfg,
+		)
+		go codeHostDispatcher.Run(ctx)
+	}
+
+	absenceCfg := services.DefaultAbsenceSchedulerConfig()
+	absenceCfg.PollInterval = time.Duration(cfg.Absence.PollInterval) * time.Second
+	absenceScheduler := services.NewAbsenceScheduler(userService, absenceCfg)
+	go absenceScheduler.Run(ctx)
+
 	go func() {
 		<-ctx.Done()
 		slog.Debug("shutting down server")
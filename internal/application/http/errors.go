@@ -44,6 +44,34 @@ var (
 		},
 	}
 
+	ErrReviewAfterMerge = ErrorResponse{
+		Error: Error{
+			Code:    "PR_MERGED",
+			Message: "cannot review merged PR",
+		},
+	}
+
+	ErrPRNotOpen = ErrorResponse{
+		Error: Error{
+			Code:    "PR_NOT_OPEN",
+			Message: "PR is draft or closed",
+		},
+	}
+
+	ErrInvalidTransition = ErrorResponse{
+		Error: Error{
+			Code:    "INVALID_TRANSITION",
+			Message: "PR status does not allow this transition",
+		},
+	}
+
+	ErrMergeBlocked = ErrorResponse{
+		Error: Error{
+			Code:    "MERGE_BLOCKED",
+			Message: "PR does not meet merge requirements",
+		},
+	}
+
 	ErrUserWasNotAssigned = ErrorResponse{
 		Error: Error{
 			Code:    "NOT_ASSIGNED",
@@ -57,6 +85,34 @@ var (
 			Message: "no active replacement candidate in team",
 		},
 	}
+
+	ErrAlreadyAssigned = ErrorResponse{
+		Error: Error{
+			Code:    "ALREADY_ASSIGNED",
+			Message: "user is already a reviewer of this PR",
+		},
+	}
+
+	ErrTooManyReviewers = ErrorResponse{
+		Error: Error{
+			Code:    "TOO_MANY_REVIEWERS",
+			Message: "PR already has as many reviewers as the team requires",
+		},
+	}
+
+	ErrReviewersAtCapacity = ErrorResponse{
+		Error: Error{
+			Code:    "REVIEWERS_AT_CAPACITY",
+			Message: "candidate reviewers are at capacity",
+		},
+	}
+
+	ErrReviewerRequested = ErrorResponse{
+		Error: Error{
+			Code:    "REVIEWER_REQUESTED",
+			Message: "reviewer was requested by the author, set force to replace",
+		},
+	}
 )
 
 func WriteError(w http.ResponseWriter, status int, errResp ErrorResponse) {
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+)
+
+const maxWebhookBodySize = 5 << 20
+
+type GitHubPullRequestEvent struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Title  string `json:"title"`
+		Draft  bool   `json:"draft"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// GitHub consumes pull_request webhooks, author login is used as users.id
+func (h *WebhookHandler) GitHub(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodySize))
+	if err != nil {
+		http.Error(w, "Invalid body", http.StatusBadRequest)
+		return
+	}
+
+	if !validGitHubSignature(h.githubSecret, body, r.Header.Get("X-Hub-Signature-256")) {
+		slog.Warn("invalid GitHub webhook signature", "delivery", r.Header.Get("X-GitHub-Delivery"))
+		http.Error(w, "Invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	if event := r.Header.Get("X-GitHub-Event"); event != "pull_request" {
+		slog.Debug("GitHub event ignored", "event", event)
+		writeWebhookResponse(w, WebhookResponse{Status: webhookStatusIgnored})
+		return
+	}
+
+	var event GitHubPullRequestEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		slog.Error("failed to decode GitHub webhook", "error", err.Error())
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	pr := models.PullRequest{
+		ID:       models.GitHubPRID(event.Repository.FullName, event.Number),
+		Name:     event.PullRequest.Title,
+		AuthorID: event.PullRequest.User.Login,
+	}
+
+	var action prAction
+	switch event.Action {
+	case "opened":
+		action = prActionOpen
+		if event.PullRequest.Draft {
+			pr.Status = models.PRStatusDraft
+		}
+	case "ready_for_review":
+		action = prActionReady
+	case "closed":
+		action = prActionClose
+		if event.PullRequest.Merged {
+			action = prActionMerge
+		}
+	case "reopened":
+		action = prActionReopen
+	default:
+		slog.Debug("GitHub pull_request action ignored", "action", event.Action, "pr_id", pr.ID)
+		writeWebhookResponse(w, WebhookResponse{Status: webhookStatusIgnored})
+		return
+	}
+
+	h.applyPRAction(w, r, action, pr)
+}
+
+// validGitHubSignature checks "sha256=<hex HMAC>" signature of the body,
+// everything is rejected when secret is not configured
+func validGitHubSignature(secret, body []byte, signature string) bool {
+	if len(secret) == 0 {
+		return false
+	}
+
+	hexMAC, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(hexMAC)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
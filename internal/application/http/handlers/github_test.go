@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGitHubSecret = "github-secret"
+
+func githubRequest(t *testing.T, event, body string) *http.Request {
+	t.Helper()
+	mac := hmac.New(sha256.New, []byte(testGitHubSecret))
+	mac.Write([]byte(body))
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/github", bytes.NewBufferString(body))
+	req.Header.Set("X-GitHub-Event", event)
+	req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	return req
+}
+
+func githubPayload(action string, draft, merged bool) string {
+	return `{
+		"action": "` + action + `",
+		"number": 42,
+		"pull_request": {
+			"title": "Add search",
+			"draft": ` + boolJSON(draft) + `,
+			"merged": ` + boolJSON(merged) + `,
+			"user": {"login": "u1"}
+		},
+		"repository": {"full_name": "octo/app"}
+	}`
+}
+
+func boolJSON(b bool) string {
+	if b {
+		return "true"
+	}
+	return "false"
+}
+
+func TestWebhookHandler_GitHub_Opened(t *testing.T) {
+	svc := &mockPRService{
+		createFn: func(ctx context.Context, pr models.PullRequest) (models.PullRequest, error) {
+			assert.Equal(t, "octo/app#42", pr.ID)
+			assert.Equal(t, "Add search", pr.Name)
+			assert.Equal(t, "u1", pr.AuthorID)
+			assert.Equal(t, models.PRStatusDraft, pr.Status)
+			return pr, nil
+		},
+	}
+	handler := NewWebhookHandler(svc, testGitHubSecret, "")
+
+	rec := httptest.NewRecorder()
+	handler.GitHub(rec, githubRequest(t, "pull_request", githubPayload("opened", true, false)))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp WebhookResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	assert.Equal(t, webhookStatusApplied, resp.Status)
+	require.NotNil(t, resp.PullRequest)
+	assert.Equal(t, "octo/app#42", resp.PullRequest.ID)
+}
+
+func TestWebhookHandler_GitHub_Transitions(t *testing.T) {
+	var called string
+	svc := &mockPRService{
+		readyFn: func(ctx context.Context, id string) (models.PullRequest, error) {
+			called = "ready " + id
+			return models.PullRequest{ID: id, Status: models.PRStatusOpen}, nil
+		},
+		closeFn: func(ctx context.Context, id string) (models.PullRequest, error) {
+			called = "close " + id
+			return models.PullRequest{ID: id, Status: models.PRStatusClosed}, nil
+		},
+		reopenFn: func(ctx context.Context, id string) (models.PullRequest, error) {
+			called = "reopen " + id
+			return models.PullRequest{ID: id, Status: models.PRStatusOpen}, nil
+		},
+		mergeFn: func(ctx context.Context, id string, force bool) (models.PullRequest, error) {
+			if !force {
+				return models.PullRequest{}, models.ErrMergeRequirementsNotMet
+			}
+			called = "force merge " + id
+			return models.PullRequest{ID: id, Status: models.PRStatusMerged, ForceMerged: true}, nil
+		},
+	}
+	handler := NewWebhookHandler(svc, testGitHubSecret, "")
+
+	tests := []struct {
+		action   string
+		merged   bool
+		expected string
+	}{
+		{action: "ready_for_review", expected: "ready octo/app#42"},
+		{action: "closed", expected: "close octo/app#42"},
+		{action: "closed", merged: true, expected: "force merge octo/app#42"},
+		{action: "reopened", expected: "reopen octo/app#42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			called = ""
+			rec := httptest.NewRecorder()
+			handler.GitHub(rec, githubRequest(t, "pull_request", githubPayload(tt.action, false, tt.merged)))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.expected, called)
+		})
+	}
+}
+
+func TestWebhookHandler_GitHub_Ignored(t *testing.T) {
+	svc := &mockPRService{
+		createFn: func(ctx context.Context, pr models.PullRequest) (models.PullRequest, error) {
+			return models.PullRequest{}, models.ErrPullRequestExists
+		},
+	}
+	handler := NewWebhookHandler(svc, testGitHubSecret, "")
+
+	tests := []struct {
+		name  string
+		event string
+		body  string
+	}{
+		{name: "ping", event: "ping", body: `{"zen": "Keep it simple."}`},
+		{name: "unknown action", event: "pull_request", body: githubPayload("labeled", false, false)},
+		{name: "redelivered opened", event: "pull_request", body: githubPayload("opened", false, false)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.GitHub(rec, githubRequest(t, tt.event, tt.body))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var resp WebhookResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(t, webhookStatusIgnored, resp.Status)
+		})
+	}
+}
+
+func TestWebhookHandler_GitHub_RedeliveredTransitions(t *testing.T) {
+	alreadyInStatus := func(ctx context.Context, id string) (models.PullRequest, error) {
+		return models.PullRequest{}, models.ErrPullRequestStatusSame
+	}
+	svc := &mockPRService{readyFn: alreadyInStatus, closeFn: alreadyInStatus, reopenFn: alreadyInStatus}
+	handler := NewWebhookHandler(svc, testGitHubSecret, "")
+
+	for _, action := range []string{"ready_for_review", "closed", "reopened"} {
+		t.Run(action, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.GitHub(rec, githubRequest(t, "pull_request", githubPayload(action, false, false)))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var resp WebhookResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(t, webhookStatusIgnored, resp.Status)
+		})
+	}
+}
+
+func TestWebhookHandler_GitHub_UnknownAuthor(t *testing.T) {
+	svc := &mockPRService{
+		createFn: func(ctx context.Context, pr models.PullRequest) (models.PullRequest, error) {
+			return models.PullRequest{}, models.ErrUserNotFound
+		},
+	}
+	handler := NewWebhookHandler(svc, testGitHubSecret, "")
+
+	rec := httptest.NewRecorder()
+	handler.GitHub(rec, githubRequest(t, "pull_request", githubPayload("opened", false, false)))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestWebhookHandler_GitHub_InvalidSignature(t *testing.T) {
+	handler := NewWebhookHandler(&mockPRService{}, testGitHubSecret, "")
+
+	tests := []struct {
+		name      string
+		signature string
+	}{
+		{name: "missing", signature: ""},
+		{name: "wrong secret", signature: "sha256=" + hex.EncodeToString(make([]byte, sha256.Size))},
+		{name: "not hex", signature: "sha256=zz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/github",
+				bytes.NewBufferString(githubPayload("opened", false, false)))
+			req.Header.Set("X-GitHub-Event", "pull_request")
+			req.Header.Set("X-Hub-Signature-256", tt.signature)
+			rec := httptest.NewRecorder()
+
+			handler.GitHub(rec, req)
+
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		})
+	}
+}
+
+func TestWebhookHandler_GitHub_SecretNotConfigured(t *testing.T) {
+	handler := NewWebhookHandler(&mockPRService{}, "", "")
+
+	rec := httptest.NewRecorder()
+	handler.GitHub(rec, githubRequest(t, "pull_request", githubPayload("opened", false, false)))
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestWebhookHandler_GitHub_DomainErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "reviewers at capacity", err: models.ErrReviewersAtCapacity, expected: http.StatusConflict},
+		{name: "inactive requested reviewer", err: models.ErrRequestedReviewerInactive, expected: http.StatusBadRequest},
+		{name: "unexpected", err: errors.New("db is down"), expected: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockPRService{
+				createFn: func(ctx context.Context, pr models.PullRequest) (models.PullRequest, error) {
+					return models.PullRequest{}, tt.err
+				},
+			}
+			handler := NewWebhookHandler(svc, testGitHubSecret, "")
+
+			rec := httptest.NewRecorder()
+			handler.GitHub(rec, githubRequest(t, "pull_request", githubPayload("opened", false, false)))
+
+			assert.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+)
+
+type GitLabMergeRequestEvent struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID    int    `json:"iid"`
+		Title  string `json:"title"`
+		Action string `json:"action"`
+		Draft  bool   `json:"draft"`
+	} `json:"object_attributes"`
+	Changes struct {
+		Draft *struct {
+			Previous bool `json:"previous"`
+			Current  bool `json:"current"`
+		} `json:"draft"`
+	} `json:"changes"`
+}
+
+// GitLab consumes merge request hooks, username of the user who opened
+// the merge request is used as users.id of the author
+func (h *WebhookHandler) GitLab(w http.ResponseWriter, r *http.Request) {
+	if !validGitLabToken(h.gitlabToken, r.Header.Get("X-Gitlab-Token")) {
+		slog.Warn("invalid GitLab webhook token", "event", r.Header.Get("X-Gitlab-Event"))
+		http.Error(w, "Invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	var event GitLabMergeRequestEvent
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxWebhookBodySize)).Decode(&event); err != nil {
+		slog.Error("failed to decode GitLab webhook", "error", err.Error())
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	if event.ObjectKind != "merge_request" {
+		slog.Debug("GitLab event ignored", "object_kind", event.ObjectKind)
+		writeWebhookResponse(w, WebhookResponse{Status: webhookStatusIgnored})
+		return
+	}
+
+	attrs := event.ObjectAttributes
+	pr := models.PullRequest{
+		ID:       models.GitLabPRID(event.Project.PathWithNamespace, attrs.IID),
+		Name:     attrs.Title,
+		AuthorID: event.User.Username,
+	}
+
+	var action prAction
+	switch attrs.Action {
+	case "open":
+		action = prActionOpen
+		if attrs.Draft {
+			pr.Status = models.PRStatusDraft
+		}
+	case "update":
+		// only leaving draft is a transition, other updates do not change the PR here
+		if draft := event.Changes.Draft; draft == nil || !draft.Previous || draft.Current {
+			slog.Debug("GitLab merge request update ignored", "pr_id", pr.ID)
+			writeWebhookResponse(w, WebhookResponse{Status: webhookStatusIgnored})
+			return
+		}
+		action = prActionReady
+	case "merge":
+		action = prActionMerge
+	case "close":
+		action = prActionClose
+	case "reopen":
+		action = prActionReopen
+	default:
+		slog.Debug("GitLab merge request action ignored", "action", attrs.Action, "pr_id", pr.ID)
+		writeWebhookResponse(w, WebhookResponse{Status: webhookStatusIgnored})
+		return
+	}
+
+	h.applyPRAction(w, r, action, pr)
+}
+
+// validGitLabToken compares token in constant time,
+// everything is rejected when token is not configured
+func validGitLabToken(expected []byte, token string) bool {
+	if len(expected) == 0 {
+		return false
+	}
+	return subtle.ConstantTimeCompare(expected, []byte(token)) == 1
+}
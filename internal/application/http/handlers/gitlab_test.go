@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGitLabToken = "gitlab-token"
+
+func gitlabRequest(t *testing.T, fixture, token string) *http.Request {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", "gitlab", fixture))
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/gitlab", bytes.NewReader(body))
+	req.Header.Set("X-Gitlab-Event", "Merge Request Hook")
+	req.Header.Set("X-Gitlab-Token", token)
+	return req
+}
+
+func TestWebhookHandler_GitLab_Open(t *testing.T) {
+	tests := []struct {
+		fixture string
+		status  models.PRStatus
+	}{
+		{fixture: "mr_open.json", status: ""},
+		{fixture: "mr_open_draft.json", status: models.PRStatusDraft},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			svc := &mockPRService{
+				createFn: func(ctx context.Context, pr models.PullRequest) (models.PullRequest, error) {
+					assert.Equal(t, "octo/app!7", pr.ID)
+					assert.Equal(t, "u1", pr.AuthorID)
+					assert.Equal(t, tt.status, pr.Status)
+					return pr, nil
+				},
+			}
+			handler := NewWebhookHandler(svc, "", testGitLabToken)
+
+			rec := httptest.NewRecorder()
+			handler.GitLab(rec, gitlabRequest(t, tt.fixture, testGitLabToken))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var resp WebhookResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(t, webhookStatusApplied, resp.Status)
+		})
+	}
+}
+
+func TestWebhookHandler_GitLab_Transitions(t *testing.T) {
+	var called string
+	svc := &mockPRService{
+		readyFn: func(ctx context.Context, id string) (models.PullRequest, error) {
+			called = "ready " + id
+			return models.PullRequest{ID: id, Status: models.PRStatusOpen}, nil
+		},
+		mergeFn: func(ctx context.Context, id string, force bool) (models.PullRequest, error) {
+			called = "merge " + id
+			return models.PullRequest{ID: id, Status: models.PRStatusMerged}, nil
+		},
+		closeFn: func(ctx context.Context, id string) (models.PullRequest, error) {
+			called = "close " + id
+			return models.PullRequest{ID: id, Status: models.PRStatusClosed}, nil
+		},
+		reopenFn: func(ctx context.Context, id string) (models.PullRequest, error) {
+			called = "reopen " + id
+			return models.PullRequest{ID: id, Status: models.PRStatusOpen}, nil
+		},
+	}
+	handler := NewWebhookHandler(svc, "", testGitLabToken)
+
+	tests := []struct {
+		fixture  string
+		expected string
+		status   string
+	}{
+		{fixture: "mr_update_ready.json", expected: "ready octo/app!7", status: webhookStatusApplied},
+		{fixture: "mr_update_title.json", expected: "", status: webhookStatusIgnored},
+		{fixture: "mr_merge.json", expected: "merge octo/app!7", status: webhookStatusApplied},
+		{fixture: "mr_close.json", expected: "close octo/app!7", status: webhookStatusApplied},
+		{fixture: "mr_reopen.json", expected: "reopen octo/app!7", status: webhookStatusApplied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			called = ""
+			rec := httptest.NewRecorder()
+			handler.GitLab(rec, gitlabRequest(t, tt.fixture, testGitLabToken))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.expected, called)
+			var resp WebhookResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(t, tt.status, resp.Status)
+		})
+	}
+}
+
+func TestWebhookHandler_GitLab_InvalidToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured string
+		token      string
+	}{
+		{name: "wrong token", configured: testGitLabToken, token: "guess"},
+		{name: "missing token", configured: testGitLabToken, token: ""},
+		{name: "token not configured", configured: "", token: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewWebhookHandler(&mockPRService{}, "", tt.configured)
+
+			rec := httptest.NewRecorder()
+			handler.GitLab(rec, gitlabRequest(t, "mr_open.json", tt.token))
+
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		})
+	}
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	httpErr "github.com/437d5/pr-review-manager/internal/application/http"
 	"github.com/437d5/pr-review-manager/internal/domain/models"
@@ -14,8 +18,17 @@ import (
 // PRService describes the subset of PR-related operations used by PRHandler.
 type PRService interface {
 	CreatePR(ctx context.Context, pr models.PullRequest) (models.PullRequest, error)
-	Merge(ctx context.Context, id string) (models.PullRequest, error)
-	ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (models.PullRequest, string, error)
+	Merge(ctx context.Context, id string, force bool) (models.PullRequest, error)
+	ReassignReviewer(ctx context.Context, prID, oldReviewerID string, opts models.ReassignOptions) (models.PullRequest, string, error)
+	AddReviewer(ctx context.Context, prID, reviewerID, actorID string) (models.PullRequest, error)
+	RemoveReviewer(ctx context.Context, prID, reviewerID, actorID string) (models.PullRequest, error)
+	SubmitReview(ctx context.Context, prID, reviewerID string, decision models.ReviewDecision) (models.PullRequest, error)
+	MarkReady(ctx context.Context, id string) (models.PullRequest, error)
+	Close(ctx context.Context, id string) (models.PullRequest, error)
+	Reopen(ctx context.Context, id string) (models.PullRequest, error)
+	GetPR(ctx context.Context, id string) (models.PullRequestDetails, error)
+	ListPRs(ctx context.Context, filter models.PRFilter) (models.PRPage, error)
+	GetHistory(ctx context.Context, id string) ([]models.AssignmentEvent, error)
 }
 
 type PRHandler struct {
@@ -30,6 +43,11 @@ type CreatePRRequest struct {
 	ID       string `json:"pull_request_id"`
 	Name     string `json:"pull_request_name"`
 	AuthorID string `json:"author_id"`
+	Draft    bool   `json:"draft"`
+	// ChangedFiles are used to prefer code owners as reviewers
+	ChangedFiles []string `json:"changed_files"`
+	// RequestedReviewers are assigned before the selected ones
+	RequestedReviewers []string `json:"requested_reviewers"`
 }
 
 type PRResponse struct {
@@ -45,18 +63,28 @@ func (h *PRHandler) CreatePR(w http.ResponseWriter, r *http.Request) {
 	}
 
 	pr := models.PullRequest{
-		ID:       req.ID,
-		Name:     req.Name,
-		AuthorID: req.AuthorID,
+		ID:                 req.ID,
+		Name:               req.Name,
+		AuthorID:           req.AuthorID,
+		ChangedFiles:       req.ChangedFiles,
+		RequestedReviewers: req.RequestedReviewers,
+	}
+	if req.Draft {
+		pr.Status = models.PRStatusDraft
 	}
 
 	createdPR, err := h.prService.CreatePR(r.Context(), pr)
 	if err != nil {
 		switch err {
+		case models.ErrEmptyUserID, models.ErrRequestedReviewerIsAuthor,
+			models.ErrRequestedReviewerInactive, models.ErrTooManyRequestedReviewers:
+			http.Error(w, err.Error(), http.StatusBadRequest)
 		case models.ErrUserNotFound, models.ErrTeamNotFound:
 			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
 		case models.ErrPullRequestExists:
 			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrPRExists)
+		case models.ErrReviewersAtCapacity:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrReviewersAtCapacity)
 		default:
 			httpErr.WriteInernalError(w, err)
 		}
@@ -71,8 +99,140 @@ func (h *PRHandler) CreatePR(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+type PRDetailsResponse struct {
+	PullRequest models.PullRequestDetails `json:"pr"`
+}
+
+func (h *PRHandler) GetPR(w http.ResponseWriter, r *http.Request) {
+	prID := r.URL.Query().Get("pull_request_id")
+
+	pr, err := h.prService.GetPR(r.Context(), prID)
+	if err != nil {
+		switch err {
+		case models.ErrPullRequestIDEmpty:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case models.ErrPullRequestNotFound:
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(PRDetailsResponse{PullRequest: pr}); err != nil {
+		slog.Error("failed to encode response", "error", err.Error(), "pr_id", prID)
+	}
+}
+
+type PRHistoryResponse struct {
+	ID     string                   `json:"pull_request_id"`
+	Events []models.AssignmentEvent `json:"events"`
+}
+
+func (h *PRHandler) GetHistory(w http.ResponseWriter, r *http.Request) {
+	prID := r.URL.Query().Get("pull_request_id")
+
+	events, err := h.prService.GetHistory(r.Context(), prID)
+	if err != nil {
+		switch err {
+		case models.ErrPullRequestIDEmpty:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case models.ErrPullRequestNotFound:
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(PRHistoryResponse{ID: prID, Events: events}); err != nil {
+		slog.Error("failed to encode response", "error", err.Error(), "pr_id", prID)
+	}
+}
+
+func (h *PRHandler) ListPRs(w http.ResponseWriter, r *http.Request) {
+	filter, err := parsePRFilter(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	page, err := h.prService.ListPRs(r.Context(), filter)
+	if err != nil {
+		switch err {
+		case models.ErrInvalidPRStatus, models.ErrInvalidPageLimit,
+			models.ErrInvalidCursor, models.ErrInvalidTimeRange:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(page); err != nil {
+		slog.Error("failed to encode response", "error", err.Error())
+	}
+}
+
+func parsePRFilter(query url.Values) (models.PRFilter, error) {
+	filter := models.PRFilter{
+		Status:     models.PRStatus(query.Get("status")),
+		AuthorID:   query.Get("author_id"),
+		ReviewerID: query.Get("reviewer_id"),
+		TeamName:   query.Get("team_name"),
+		Cursor:     query.Get("cursor"),
+	}
+
+	var err error
+	if filter.Limit, err = parseLimit(query); err != nil {
+		return models.PRFilter{}, err
+	}
+	if filter.CreatedFrom, err = parseTimeParam(query, "created_from"); err != nil {
+		return models.PRFilter{}, err
+	}
+	if filter.CreatedTo, err = parseTimeParam(query, "created_to"); err != nil {
+		return models.PRFilter{}, err
+	}
+
+	return filter, nil
+}
+
+// parseLimit returns zero if limit is not set so that default is applied
+func parseLimit(query url.Values) (int, error) {
+	limit := query.Get("limit")
+	if limit == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.Atoi(limit)
+	if err != nil {
+		return 0, models.ErrInvalidPageLimit
+	}
+	return n, nil
+}
+
+func parseTimeParam(query url.Values, name string) (*time.Time, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be RFC3339 date-time", name)
+	}
+	return &t, nil
+}
+
 type MergeRequest struct {
-	ID string `json:"pull_request_id"`
+	ID    string `json:"pull_request_id"`
+	Force bool   `json:"force"`
 }
 
 func (h *PRHandler) Merge(w http.ResponseWriter, r *http.Request) {
@@ -83,10 +243,14 @@ func (h *PRHandler) Merge(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	mergedPR, err := h.prService.Merge(r.Context(), req.ID)
+	mergedPR, err := h.prService.Merge(r.Context(), req.ID, req.Force)
 	if err != nil {
 		if errors.Is(err, models.ErrPullRequestNotFound) {
 			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		} else if errors.Is(err, models.ErrPullRequestNotOpen) {
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrPRNotOpen)
+		} else if errors.Is(err, models.ErrMergeRequirementsNotMet) {
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrMergeBlocked)
 		} else if !errors.Is(err, models.ErrPullRequestAlreadyMerged) {
 			httpErr.WriteInernalError(w, err)
 		}
@@ -106,6 +270,12 @@ func (h *PRHandler) Merge(w http.ResponseWriter, r *http.Request) {
 type ReassignRequest struct {
 	ID            string `json:"pull_request_id"`
 	OldReviewerID string `json:"old_reviewer_id"`
+	ActorID       string `json:"actor_id"`
+	Reason        string `json:"reason"`
+	// Force allows to replace a reviewer requested by the author
+	Force bool `json:"force"`
+	// NewReviewerID is the chosen replacement, the team strategy picks one when it is empty
+	NewReviewerID string `json:"new_reviewer_id"`
 }
 
 type ReassignResponse struct {
@@ -121,19 +291,32 @@ func (h *PRHandler) Reassign(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	updatedPR, newReviewerID, err := h.prService.ReassignReviewer(r.Context(), req.ID, req.OldReviewerID)
+	updatedPR, newReviewerID, err := h.prService.ReassignReviewer(r.Context(), req.ID, req.OldReviewerID, models.ReassignOptions{
+		ActorID:       req.ActorID,
+		Reason:        req.Reason,
+		Force:         req.Force,
+		NewReviewerID: req.NewReviewerID,
+	})
 	if err != nil {
 		switch err {
 		case models.ErrEmptyUserID, models.ErrPullRequestIDEmpty:
 			http.Error(w, "Invalid request", http.StatusBadRequest)
+		case models.ErrNotReassignCandidate:
+			http.Error(w, err.Error(), http.StatusBadRequest)
 		case models.ErrPullRequestNotFound, models.ErrUserNotFound:
 			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
 		case models.ErrPullRequestAlreadyMerged:
 			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrCannotChangeAfterMerge)
+		case models.ErrPullRequestNotOpen:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrPRNotOpen)
 		case models.ErrUserNotReviewer:
 			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrUserWasNotAssigned)
 		case models.ErrNoCandidateToReassign:
 			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrNoCandidate)
+		case models.ErrReviewersAtCapacity:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrReviewersAtCapacity)
+		case models.ErrReviewerRequested:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrReviewerRequested)
 		default:
 			httpErr.WriteInernalError(w, err)
 		}
@@ -152,3 +335,186 @@ func (h *PRHandler) Reassign(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+type ChangeReviewerRequest struct {
+	ID         string `json:"pull_request_id"`
+	ReviewerID string `json:"user_id"`
+	ActorID    string `json:"actor_id"`
+}
+
+func (h *PRHandler) AddReviewer(w http.ResponseWriter, r *http.Request) {
+	var req ChangeReviewerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("failed to decode request", "error", err.Error())
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	updatedPR, err := h.prService.AddReviewer(r.Context(), req.ID, req.ReviewerID, req.ActorID)
+	if err != nil {
+		switch err {
+		case models.ErrEmptyUserID, models.ErrPullRequestIDEmpty, models.ErrReviewerIsAuthor,
+			models.ErrReviewerInactive, models.ErrReviewerNotInTeam:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case models.ErrPullRequestNotFound, models.ErrUserNotFound:
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		case models.ErrPullRequestAlreadyMerged:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrCannotChangeAfterMerge)
+		case models.ErrPullRequestNotOpen:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrPRNotOpen)
+		case models.ErrReviewerAlreadyAssigned:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrAlreadyAssigned)
+		case models.ErrTooManyReviewers:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrTooManyReviewers)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	res := PRResponse{PullRequest: updatedPR}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		slog.Error("failed to encode response", "error", err.Error(), "response", res)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *PRHandler) RemoveReviewer(w http.ResponseWriter, r *http.Request) {
+	var req ChangeReviewerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("failed to decode request", "error", err.Error())
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	updatedPR, err := h.prService.RemoveReviewer(r.Context(), req.ID, req.ReviewerID, req.ActorID)
+	if err != nil {
+		switch err {
+		case models.ErrEmptyUserID, models.ErrPullRequestIDEmpty:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case models.ErrPullRequestNotFound:
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		case models.ErrPullRequestAlreadyMerged:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrCannotChangeAfterMerge)
+		case models.ErrPullRequestNotOpen:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrPRNotOpen)
+		case models.ErrUserNotReviewer:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrUserWasNotAssigned)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	res := PRResponse{PullRequest: updatedPR}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		slog.Error("failed to encode response", "error", err.Error(), "response", res)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+type ReviewRequest struct {
+	ID         string                `json:"pull_request_id"`
+	ReviewerID string                `json:"reviewer_id"`
+	Decision   models.ReviewDecision `json:"decision"`
+}
+
+func (h *PRHandler) Review(w http.ResponseWriter, r *http.Request) {
+	var req ReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("failed to decode request", "error", err.Error())
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	reviewedPR, err := h.prService.SubmitReview(r.Context(), req.ID, req.ReviewerID, req.Decision)
+	if err != nil {
+		switch err {
+		case models.ErrEmptyUserID, models.ErrPullRequestIDEmpty, models.ErrInvalidReviewDecision:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case models.ErrPullRequestNotFound:
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		case models.ErrPullRequestAlreadyMerged:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrReviewAfterMerge)
+		case models.ErrPullRequestNotOpen:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrPRNotOpen)
+		case models.ErrUserNotReviewer:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrUserWasNotAssigned)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	res := PRResponse{PullRequest: reviewedPR}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		slog.Error("failed to encode response", "error", err.Error(), "response", res)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+type ChangeStatusRequest struct {
+	ID string `json:"pull_request_id"`
+}
+
+func (h *PRHandler) MarkReady(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, h.prService.MarkReady)
+}
+
+func (h *PRHandler) Close(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, h.prService.Close)
+}
+
+func (h *PRHandler) Reopen(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, h.prService.Reopen)
+}
+
+func (h *PRHandler) changeStatus(
+	w http.ResponseWriter,
+	r *http.Request,
+	change func(ctx context.Context, id string) (models.PullRequest, error),
+) {
+	var req ChangeStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("failed to decode request", "error", err.Error())
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	updatedPR, err := change(r.Context(), req.ID)
+	if err != nil {
+		switch err {
+		case models.ErrPullRequestIDEmpty:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case models.ErrPullRequestNotFound, models.ErrUserNotFound, models.ErrTeamNotFound:
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		case models.ErrPullRequestAlreadyMerged:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrCannotChangeAfterMerge)
+		case models.ErrInvalidStatusTransition, models.ErrPullRequestStatusSame:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrInvalidTransition)
+		case models.ErrReviewersAtCapacity:
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrReviewersAtCapacity)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	res := PRResponse{PullRequest: updatedPR}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		slog.Error("failed to encode response", "error", err.Error(), "response", res)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
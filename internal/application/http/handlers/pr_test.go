@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	httpErr "github.com/437d5/pr-review-manager/internal/application/http"
 	"github.com/437d5/pr-review-manager/internal/domain/models"
@@ -17,20 +18,69 @@ import (
 
 type mockPRService struct {
 	createFn   func(ctx context.Context, pr models.PullRequest) (models.PullRequest, error)
-	mergeFn    func(ctx context.Context, id string) (models.PullRequest, error)
-	reassignFn func(ctx context.Context, prID, oldReviewerID string) (models.PullRequest, string, error)
+	mergeFn    func(ctx context.Context, id string, force bool) (models.PullRequest, error)
+	reassignFn func(ctx context.Context, prID, oldReviewerID string, opts models.ReassignOptions) (models.PullRequest, string, error)
+	addFn      func(ctx context.Context, prID, reviewerID, actorID string) (models.PullRequest, error)
+	removeFn   func(ctx context.Context, prID, reviewerID, actorID string) (models.PullRequest, error)
+	reviewFn   func(ctx context.Context, prID, reviewerID string, decision models.ReviewDecision) (models.PullRequest, error)
+	readyFn    func(ctx context.Context, id string) (models.PullRequest, error)
+	closeFn    func(ctx context.Context, id string) (models.PullRequest, error)
+	reopenFn   func(ctx context.Context, id string) (models.PullRequest, error)
+	getFn      func(ctx context.Context, id string) (models.PullRequestDetails, error)
+	listFn     func(ctx context.Context, filter models.PRFilter) (models.PRPage, error)
+	historyFn  func(ctx context.Context, id string) ([]models.AssignmentEvent, error)
 }
 
 func (m *mockPRService) CreatePR(ctx context.Context, pr models.PullRequest) (models.PullRequest, error) {
 	return m.createFn(ctx, pr)
 }
 
-func (m *mockPRService) Merge(ctx context.Context, id string) (models.PullRequest, error) {
-	return m.mergeFn(ctx, id)
+func (m *mockPRService) Merge(ctx context.Context, id string, force bool) (models.PullRequest, error) {
+	return m.mergeFn(ctx, id, force)
 }
 
-func (m *mockPRService) ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (models.PullRequest, string, error) {
-	return m.reassignFn(ctx, prID, oldReviewerID)
+func (m *mockPRService) ReassignReviewer(
+	ctx context.Context,
+	prID, oldReviewerID string,
+	opts models.ReassignOptions,
+) (models.PullRequest, string, error) {
+	return m.reassignFn(ctx, prID, oldReviewerID, opts)
+}
+
+func (m *mockPRService) AddReviewer(ctx context.Context, prID, reviewerID, actorID string) (models.PullRequest, error) {
+	return m.addFn(ctx, prID, reviewerID, actorID)
+}
+
+func (m *mockPRService) RemoveReviewer(ctx context.Context, prID, reviewerID, actorID string) (models.PullRequest, error) {
+	return m.removeFn(ctx, prID, reviewerID, actorID)
+}
+
+func (m *mockPRService) SubmitReview(ctx context.Context, prID, reviewerID string, decision models.ReviewDecision) (models.PullRequest, error) {
+	return m.reviewFn(ctx, prID, reviewerID, decision)
+}
+
+func (m *mockPRService) MarkReady(ctx context.Context, id string) (models.PullRequest, error) {
+	return m.readyFn(ctx, id)
+}
+
+func (m *mockPRService) Close(ctx context.Context, id string) (models.PullRequest, error) {
+	return m.closeFn(ctx, id)
+}
+
+func (m *mockPRService) Reopen(ctx context.Context, id string) (models.PullRequest, error) {
+	return m.reopenFn(ctx, id)
+}
+
+func (m *mockPRService) GetPR(ctx context.Context, id string) (models.PullRequestDetails, error) {
+	return m.getFn(ctx, id)
+}
+
+func (m *mockPRService) ListPRs(ctx context.Context, filter models.PRFilter) (models.PRPage, error) {
+	return m.listFn(ctx, filter)
+}
+
+func (m *mockPRService) GetHistory(ctx context.Context, id string) ([]models.AssignmentEvent, error) {
+	return m.historyFn(ctx, id)
 }
 
 // helper to read response body
@@ -44,6 +94,7 @@ func readBody(t *testing.T, r io.Reader) string {
 func TestPRHandler_CreatePR_Success(t *testing.T) {
 	svc := &mockPRService{
 		createFn: func(ctx context.Context, pr models.PullRequest) (models.PullRequest, error) {
+			assert.Equal(t, []string{"api/handler.go"}, pr.ChangedFiles)
 			pr.Status = models.PRStatusOpen
 			pr.AssignedReviewers = []string{"u2", "u3"}
 			return pr, nil
@@ -52,9 +103,10 @@ func TestPRHandler_CreatePR_Success(t *testing.T) {
 	handler := &PRHandler{prService: svc}
 
 	body := CreatePRRequest{
-		ID:       "pr-1",
-		Name:     "Feature",
-		AuthorID: "u1",
+		ID:           "pr-1",
+		Name:         "Feature",
+		AuthorID:     "u1",
+		ChangedFiles: []string{"api/handler.go"},
 	}
 	data, err := json.Marshal(body)
 	require.NoError(t, err)
@@ -133,3 +185,523 @@ func TestPRHandler_CreatePR_ErrorMapping_NotFound(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, httpErr.ErrNotFound.Error.Code, errResp.Error.Code)
 }
+
+func TestPRHandler_CreatePR_ErrorMapping_RequestedReviewer(t *testing.T) {
+	svc := &mockPRService{
+		createFn: func(ctx context.Context, pr models.PullRequest) (models.PullRequest, error) {
+			assert.Equal(t, []string{"u1"}, pr.RequestedReviewers)
+			return models.PullRequest{}, models.ErrRequestedReviewerIsAuthor
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	body := CreatePRRequest{
+		ID:                 "pr-1",
+		Name:               "Feature",
+		AuthorID:           "u1",
+		RequestedReviewers: []string{"u1"},
+	}
+	data, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/create", bytes.NewReader(data))
+	rec := httptest.NewRecorder()
+
+	handler.CreatePR(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestPRHandler_Reassign_ErrorMapping_Requested(t *testing.T) {
+	svc := &mockPRService{
+		reassignFn: func(ctx context.Context, prID, oldReviewerID string, opts models.ReassignOptions) (models.PullRequest, string, error) {
+			assert.False(t, opts.Force)
+			return models.PullRequest{}, "", models.ErrReviewerRequested
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/reassign",
+		bytes.NewReader([]byte(`{"pull_request_id":"pr-1","old_reviewer_id":"u2"}`)))
+	rec := httptest.NewRecorder()
+
+	handler.Reassign(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusConflict, res.StatusCode)
+
+	var errResp httpErr.ErrorResponse
+	err := json.NewDecoder(res.Body).Decode(&errResp)
+	require.NoError(t, err)
+	assert.Equal(t, httpErr.ErrReviewerRequested.Error.Code, errResp.Error.Code)
+}
+
+func TestPRHandler_Reassign_ChosenReviewer(t *testing.T) {
+	svc := &mockPRService{
+		reassignFn: func(ctx context.Context, prID, oldReviewerID string, opts models.ReassignOptions) (models.PullRequest, string, error) {
+			assert.Equal(t, "u2", oldReviewerID)
+			assert.Equal(t, "u5", opts.NewReviewerID)
+			return models.PullRequest{ID: prID, AssignedReviewers: []string{"u5", "u3"}}, opts.NewReviewerID, nil
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/reassign",
+		bytes.NewBufferString(`{"pull_request_id":"pr-1","old_reviewer_id":"u2","new_reviewer_id":"u5"}`))
+	rec := httptest.NewRecorder()
+
+	handler.Reassign(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var resp ReassignResponse
+	err := json.NewDecoder(res.Body).Decode(&resp)
+	require.NoError(t, err)
+	assert.Equal(t, "u5", resp.NewReviewerID)
+}
+
+func TestPRHandler_Reassign_ErrorMapping_NotCandidate(t *testing.T) {
+	svc := &mockPRService{
+		reassignFn: func(ctx context.Context, prID, oldReviewerID string, opts models.ReassignOptions) (models.PullRequest, string, error) {
+			return models.PullRequest{}, "", models.ErrNotReassignCandidate
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/reassign",
+		bytes.NewBufferString(`{"pull_request_id":"pr-1","old_reviewer_id":"u2","new_reviewer_id":"u1"}`))
+	rec := httptest.NewRecorder()
+
+	handler.Reassign(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestPRHandler_AddReviewer_Success(t *testing.T) {
+	svc := &mockPRService{
+		addFn: func(ctx context.Context, prID, reviewerID, actorID string) (models.PullRequest, error) {
+			assert.Equal(t, "u4", reviewerID)
+			assert.Equal(t, "u1", actorID)
+			return models.PullRequest{
+				ID:                prID,
+				Status:            models.PRStatusOpen,
+				AssignedReviewers: []string{"u2", "u3", "u4"},
+			}, nil
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/addReviewer",
+		bytes.NewBufferString(`{"pull_request_id":"pr-1","user_id":"u4","actor_id":"u1"}`))
+	rec := httptest.NewRecorder()
+
+	handler.AddReviewer(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var resp PRResponse
+	err := json.NewDecoder(res.Body).Decode(&resp)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2", "u3", "u4"}, resp.PullRequest.AssignedReviewers)
+}
+
+func TestPRHandler_AddReviewer_ErrorMapping(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+	}{
+		{"merged", models.ErrPullRequestAlreadyMerged, http.StatusConflict, httpErr.ErrCannotChangeAfterMerge.Error.Code},
+		{"already assigned", models.ErrReviewerAlreadyAssigned, http.StatusConflict, httpErr.ErrAlreadyAssigned.Error.Code},
+		{"too many", models.ErrTooManyReviewers, http.StatusConflict, httpErr.ErrTooManyReviewers.Error.Code},
+		{"not in team", models.ErrReviewerNotInTeam, http.StatusBadRequest, ""},
+		{"author", models.ErrReviewerIsAuthor, http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &mockPRService{
+				addFn: func(ctx context.Context, prID, reviewerID, actorID string) (models.PullRequest, error) {
+					return models.PullRequest{}, tt.err
+				},
+			}
+			handler := &PRHandler{prService: svc}
+
+			req := httptest.NewRequest(http.MethodPost, "/pullRequest/addReviewer",
+				bytes.NewBufferString(`{"pull_request_id":"pr-1","user_id":"u4"}`))
+			rec := httptest.NewRecorder()
+
+			handler.AddReviewer(rec, req)
+
+			res := rec.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tt.status, res.StatusCode)
+			if tt.code != "" {
+				var errResp httpErr.ErrorResponse
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&errResp))
+				assert.Equal(t, tt.code, errResp.Error.Code)
+			}
+		})
+	}
+}
+
+func TestPRHandler_RemoveReviewer_ErrorMapping_NotAssigned(t *testing.T) {
+	svc := &mockPRService{
+		removeFn: func(ctx context.Context, prID, reviewerID, actorID string) (models.PullRequest, error) {
+			return models.PullRequest{}, models.ErrUserNotReviewer
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/removeReviewer",
+		bytes.NewBufferString(`{"pull_request_id":"pr-1","user_id":"u9"}`))
+	rec := httptest.NewRecorder()
+
+	handler.RemoveReviewer(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusConflict, res.StatusCode)
+
+	var errResp httpErr.ErrorResponse
+	err := json.NewDecoder(res.Body).Decode(&errResp)
+	require.NoError(t, err)
+	assert.Equal(t, httpErr.ErrUserWasNotAssigned.Error.Code, errResp.Error.Code)
+}
+
+func TestPRHandler_Merge_Blocked(t *testing.T) {
+	svc := &mockPRService{
+		mergeFn: func(ctx context.Context, id string, force bool) (models.PullRequest, error) {
+			assert.False(t, force)
+			return models.PullRequest{}, models.ErrMergeRequirementsNotMet
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/merge",
+		bytes.NewBufferString(`{"pull_request_id":"pr-1"}`))
+	rec := httptest.NewRecorder()
+
+	handler.Merge(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusConflict, res.StatusCode)
+
+	var errResp httpErr.ErrorResponse
+	err := json.NewDecoder(res.Body).Decode(&errResp)
+	require.NoError(t, err)
+	assert.Equal(t, httpErr.ErrMergeBlocked.Error.Code, errResp.Error.Code)
+}
+
+func TestPRHandler_Merge_Force(t *testing.T) {
+	svc := &mockPRService{
+		mergeFn: func(ctx context.Context, id string, force bool) (models.PullRequest, error) {
+			return models.PullRequest{ID: id, Status: models.PRStatusMerged, ForceMerged: force}, nil
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/merge",
+		bytes.NewBufferString(`{"pull_request_id":"pr-1","force":true}`))
+	rec := httptest.NewRecorder()
+
+	handler.Merge(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var resp PRResponse
+	err := json.NewDecoder(res.Body).Decode(&resp)
+	require.NoError(t, err)
+	assert.True(t, resp.PullRequest.ForceMerged)
+}
+
+func TestPRHandler_Review_Success(t *testing.T) {
+	svc := &mockPRService{
+		reviewFn: func(ctx context.Context, prID, reviewerID string, decision models.ReviewDecision) (models.PullRequest, error) {
+			return models.PullRequest{
+				ID:                prID,
+				Status:            models.PRStatusOpen,
+				AssignedReviewers: []string{reviewerID, "u3"},
+				Reviews: []models.Review{
+					{ReviewerID: reviewerID, Decision: decision},
+					{ReviewerID: "u3", Decision: models.ReviewDecisionPending},
+				},
+			}, nil
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	data, err := json.Marshal(ReviewRequest{
+		ID:         "pr-1",
+		ReviewerID: "u2",
+		Decision:   models.ReviewDecisionChangesRequested,
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/review", bytes.NewReader(data))
+	rec := httptest.NewRecorder()
+
+	handler.Review(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var resp PRResponse
+	err = json.NewDecoder(res.Body).Decode(&resp)
+	require.NoError(t, err)
+	require.Len(t, resp.PullRequest.Reviews, 2)
+	assert.Equal(t, models.ReviewDecisionChangesRequested, resp.PullRequest.Reviews[0].Decision)
+	assert.Equal(t, models.ReviewDecisionPending, resp.PullRequest.Reviews[1].Decision)
+}
+
+func TestPRHandler_Review_ErrorMapping_NotAssigned(t *testing.T) {
+	svc := &mockPRService{
+		reviewFn: func(ctx context.Context, prID, reviewerID string, decision models.ReviewDecision) (models.PullRequest, error) {
+			return models.PullRequest{}, models.ErrUserNotReviewer
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/review",
+		bytes.NewBufferString(`{"pull_request_id":"pr-1","reviewer_id":"u9","decision":"APPROVED"}`))
+	rec := httptest.NewRecorder()
+
+	handler.Review(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusConflict, res.StatusCode)
+
+	var errResp httpErr.ErrorResponse
+	err := json.NewDecoder(res.Body).Decode(&errResp)
+	require.NoError(t, err)
+	assert.Equal(t, httpErr.ErrUserWasNotAssigned.Error.Code, errResp.Error.Code)
+}
+
+func TestPRHandler_Close_Success(t *testing.T) {
+	svc := &mockPRService{
+		closeFn: func(ctx context.Context, id string) (models.PullRequest, error) {
+			return models.PullRequest{ID: id, Status: models.PRStatusClosed}, nil
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/close",
+		bytes.NewBufferString(`{"pull_request_id":"pr-1"}`))
+	rec := httptest.NewRecorder()
+
+	handler.Close(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var resp PRResponse
+	err := json.NewDecoder(res.Body).Decode(&resp)
+	require.NoError(t, err)
+	assert.Equal(t, models.PRStatusClosed, resp.PullRequest.Status)
+}
+
+func TestPRHandler_Reopen_ErrorMapping_InvalidTransition(t *testing.T) {
+	svc := &mockPRService{
+		reopenFn: func(ctx context.Context, id string) (models.PullRequest, error) {
+			return models.PullRequest{}, models.ErrInvalidStatusTransition
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/reopen",
+		bytes.NewBufferString(`{"pull_request_id":"pr-1"}`))
+	rec := httptest.NewRecorder()
+
+	handler.Reopen(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusConflict, res.StatusCode)
+
+	var errResp httpErr.ErrorResponse
+	err := json.NewDecoder(res.Body).Decode(&errResp)
+	require.NoError(t, err)
+	assert.Equal(t, httpErr.ErrInvalidTransition.Error.Code, errResp.Error.Code)
+}
+
+func TestPRHandler_GetPR_Success(t *testing.T) {
+	createdAt := "2025-10-24T10:00:00Z"
+	svc := &mockPRService{
+		getFn: func(ctx context.Context, id string) (models.PullRequestDetails, error) {
+			return models.PullRequestDetails{
+				PullRequest: models.PullRequest{
+					ID:                id,
+					Status:            models.PRStatusOpen,
+					AssignedReviewers: []string{"u2"},
+					CreatedAt:         &createdAt,
+				},
+				Reviewers: []models.Reviewer{
+					{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true, AssignedAt: createdAt},
+				},
+			}, nil
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	req := httptest.NewRequest(http.MethodGet, "/pullRequest/get?pull_request_id=pr-1", nil)
+	rec := httptest.NewRecorder()
+
+	handler.GetPR(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var resp PRDetailsResponse
+	err := json.NewDecoder(res.Body).Decode(&resp)
+	require.NoError(t, err)
+	assert.Equal(t, "pr-1", resp.PullRequest.ID)
+	require.NotNil(t, resp.PullRequest.CreatedAt)
+	assert.Equal(t, createdAt, *resp.PullRequest.CreatedAt)
+	require.Len(t, resp.PullRequest.Reviewers, 1)
+	assert.Equal(t, "Bob", resp.PullRequest.Reviewers[0].Username)
+	assert.Equal(t, "backend", resp.PullRequest.Reviewers[0].TeamName)
+}
+
+func TestPRHandler_GetPR_NotFound(t *testing.T) {
+	svc := &mockPRService{
+		getFn: func(ctx context.Context, id string) (models.PullRequestDetails, error) {
+			return models.PullRequestDetails{}, models.ErrPullRequestNotFound
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	req := httptest.NewRequest(http.MethodGet, "/pullRequest/get?pull_request_id=missing", nil)
+	rec := httptest.NewRecorder()
+
+	handler.GetPR(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestPRHandler_GetHistory_Success(t *testing.T) {
+	svc := &mockPRService{
+		historyFn: func(ctx context.Context, id string) ([]models.AssignmentEvent, error) {
+			return []models.AssignmentEvent{
+				{ID: 1, Type: models.EventPRCreated, PullRequestID: id, UserID: "u1"},
+				{ID: 2, Type: models.EventReviewerReassigned, PullRequestID: id, UserID: "u3", OldUserID: "u2", ActorID: "u1"},
+			}, nil
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	req := httptest.NewRequest(http.MethodGet, "/pullRequest/history?pull_request_id=pr-1", nil)
+	rec := httptest.NewRecorder()
+
+	handler.GetHistory(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var resp PRHistoryResponse
+	err := json.NewDecoder(res.Body).Decode(&resp)
+	require.NoError(t, err)
+	assert.Equal(t, "pr-1", resp.ID)
+	require.Len(t, resp.Events, 2)
+	assert.Equal(t, models.EventReviewerReassigned, resp.Events[1].Type)
+	assert.Equal(t, "u2", resp.Events[1].OldUserID)
+}
+
+func TestPRHandler_GetHistory_NotFound(t *testing.T) {
+	svc := &mockPRService{
+		historyFn: func(ctx context.Context, id string) ([]models.AssignmentEvent, error) {
+			return nil, models.ErrPullRequestNotFound
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	req := httptest.NewRequest(http.MethodGet, "/pullRequest/history?pull_request_id=missing", nil)
+	rec := httptest.NewRecorder()
+
+	handler.GetHistory(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestPRHandler_ListPRs_Success(t *testing.T) {
+	svc := &mockPRService{
+		listFn: func(ctx context.Context, filter models.PRFilter) (models.PRPage, error) {
+			assert.Equal(t, models.PRStatusOpen, filter.Status)
+			assert.Equal(t, "backend", filter.TeamName)
+			assert.Equal(t, "u2", filter.ReviewerID)
+			assert.Equal(t, 10, filter.Limit)
+			assert.Equal(t, "abc", filter.Cursor)
+			require.NotNil(t, filter.CreatedFrom)
+			assert.Equal(t, time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), filter.CreatedFrom.UTC())
+			assert.Nil(t, filter.CreatedTo)
+
+			return models.PRPage{
+				PullRequests: []models.PullRequest{{ID: "pr-1", Status: models.PRStatusOpen}},
+				NextCursor:   "next",
+			}, nil
+		},
+	}
+	handler := &PRHandler{prService: svc}
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/pullRequest/list?status=OPEN&team_name=backend&reviewer_id=u2&limit=10&cursor=abc&created_from=2025-10-01T00:00:00Z", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ListPRs(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var resp models.PRPage
+	err := json.NewDecoder(res.Body).Decode(&resp)
+	require.NoError(t, err)
+	require.Len(t, resp.PullRequests, 1)
+	assert.Equal(t, "next", resp.NextCursor)
+}
+
+func TestPRHandler_ListPRs_InvalidQuery(t *testing.T) {
+	handler := &PRHandler{prService: &mockPRService{}}
+
+	for _, query := range []string{"limit=ten", "created_to=yesterday"} {
+		req := httptest.NewRequest(http.MethodGet, "/pullRequest/list?"+query, nil)
+		rec := httptest.NewRecorder()
+
+		handler.ListPRs(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+	}
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	httpErr "github.com/437d5/pr-review-manager/internal/application/http"
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+)
+
+// StatsService describes statistics queries used by StatsHandler.
+type StatsService interface {
+	GetReviewerStats(ctx context.Context, filter models.StatsFilter) ([]models.ReviewerStats, error)
+	GetTeamStats(ctx context.Context, teamName string, weeks int) (models.TeamStats, error)
+}
+
+type StatsHandler struct {
+	statsService StatsService
+}
+
+func NewStatsHandler(statsService StatsService) *StatsHandler {
+	return &StatsHandler{statsService: statsService}
+}
+
+type ReviewerStatsResponse struct {
+	Reviewers []models.ReviewerStats `json:"reviewers"`
+}
+
+func (h *StatsHandler) GetReviewerStats(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	filter := models.StatsFilter{TeamName: query.Get("team_name")}
+
+	var err error
+	if filter.From, err = parseTimeParam(query, "from"); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if filter.To, err = parseTimeParam(query, "to"); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	stats, err := h.statsService.GetReviewerStats(r.Context(), filter)
+	if err != nil {
+		switch err {
+		case models.ErrInvalidTimeRange:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case models.ErrTeamNotFound:
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(ReviewerStatsResponse{Reviewers: stats}); err != nil {
+		slog.Error("failed to encode response", "error", err.Error())
+	}
+}
+
+func (h *StatsHandler) GetTeamStats(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var weeks int
+	if value := query.Get("weeks"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			http.Error(w, models.ErrInvalidWeeks.Error(), http.StatusBadRequest)
+			return
+		}
+		weeks = n
+	}
+
+	stats, err := h.statsService.GetTeamStats(r.Context(), query.Get("team_name"), weeks)
+	if err != nil {
+		switch err {
+		case models.ErrTeamNameEmpty, models.ErrInvalidWeeks:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case models.ErrTeamNotFound:
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(stats); err != nil {
+		slog.Error("failed to encode response", "error", err.Error(), "team", stats.TeamName)
+	}
+}
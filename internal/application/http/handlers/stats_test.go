@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	httpErr "github.com/437d5/pr-review-manager/internal/application/http"
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockStatsService struct {
+	reviewerStatsFn func(ctx context.Context, filter models.StatsFilter) ([]models.ReviewerStats, error)
+	teamStatsFn     func(ctx context.Context, teamName string, weeks int) (models.TeamStats, error)
+}
+
+func (m *mockStatsService) GetReviewerStats(ctx context.Context, filter models.StatsFilter) ([]models.ReviewerStats, error) {
+	return m.reviewerStatsFn(ctx, filter)
+}
+
+func (m *mockStatsService) GetTeamStats(ctx context.Context, teamName string, weeks int) (models.TeamStats, error) {
+	return m.teamStatsFn(ctx, teamName, weeks)
+}
+
+func TestStatsHandler_GetReviewerStats_Success(t *testing.T) {
+	svc := &mockStatsService{
+		reviewerStatsFn: func(ctx context.Context, filter models.StatsFilter) ([]models.ReviewerStats, error) {
+			assert.Equal(t, "backend", filter.TeamName)
+			require.NotNil(t, filter.From)
+			assert.Equal(t, time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), filter.From.UTC())
+			assert.Nil(t, filter.To)
+
+			return []models.ReviewerStats{
+				{UserID: "u2", Username: "Bob", TeamName: "backend", TotalAssignments: 4, OpenAssignments: 2},
+			}, nil
+		},
+	}
+	handler := NewStatsHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/reviewers?team_name=backend&from=2025-10-01T00:00:00Z", nil)
+	rec := httptest.NewRecorder()
+
+	handler.GetReviewerStats(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var resp ReviewerStatsResponse
+	err := json.NewDecoder(res.Body).Decode(&resp)
+	require.NoError(t, err)
+	require.Len(t, resp.Reviewers, 1)
+	assert.Equal(t, 4, resp.Reviewers[0].TotalAssignments)
+	assert.Nil(t, resp.Reviewers[0].AvgTimeToMergeSeconds)
+}
+
+func TestStatsHandler_GetReviewerStats_TeamNotFound(t *testing.T) {
+	svc := &mockStatsService{
+		reviewerStatsFn: func(ctx context.Context, filter models.StatsFilter) ([]models.ReviewerStats, error) {
+			return nil, models.ErrTeamNotFound
+		},
+	}
+	handler := NewStatsHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/reviewers?team_name=missing", nil)
+	rec := httptest.NewRecorder()
+
+	handler.GetReviewerStats(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	var errResp httpErr.ErrorResponse
+	err := json.NewDecoder(res.Body).Decode(&errResp)
+	require.NoError(t, err)
+	assert.Equal(t, httpErr.ErrNotFound.Error.Code, errResp.Error.Code)
+}
+
+func TestStatsHandler_GetTeamStats_Success(t *testing.T) {
+	svc := &mockStatsService{
+		teamStatsFn: func(ctx context.Context, teamName string, weeks int) (models.TeamStats, error) {
+			assert.Equal(t, "backend", teamName)
+			assert.Equal(t, 4, weeks)
+			return models.TeamStats{
+				TeamName:      teamName,
+				OpenPRs:       3,
+				MergedPerWeek: []models.WeeklyCount{{WeekStart: "2025-10-20", Count: 2}},
+				ReviewLoad:    models.ReviewLoadSpread{Members: 2, Min: 1, Max: 3, StdDev: 1},
+			}, nil
+		},
+	}
+	handler := NewStatsHandler(svc)
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/team?team_name=backend&weeks=4", nil)
+	rec := httptest.NewRecorder()
+
+	handler.GetTeamStats(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var resp models.TeamStats
+	err := json.NewDecoder(res.Body).Decode(&resp)
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.OpenPRs)
+	assert.Nil(t, resp.MedianTimeToMergeSeconds)
+	assert.Equal(t, 3, resp.ReviewLoad.Max)
+}
+
+func TestStatsHandler_GetTeamStats_InvalidWeeks(t *testing.T) {
+	handler := NewStatsHandler(&mockStatsService{})
+
+	req := httptest.NewRequest(http.MethodGet, "/stats/team?team_name=backend&weeks=many", nil)
+	rec := httptest.NewRecorder()
+
+	handler.GetTeamStats(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
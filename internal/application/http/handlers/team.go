@@ -13,6 +13,11 @@ import (
 type TeamService interface {
 	CreateTeam(ctx context.Context, team models.Team) (models.Team, error)
 	GetTeam(ctx context.Context, name string) (models.Team, error)
+	UpdateSettings(ctx context.Context, name string, patch models.TeamSettingsPatch) (models.TeamSettings, error)
+	DeactivateUsers(ctx context.Context, name string, userIDs []string) ([]models.User, models.ReassignmentReport, error)
+	SetCodeOwners(ctx context.Context, name, content string) ([]models.OwnershipRule, error)
+	ValidateCodeOwners(ctx context.Context, name, content string) (models.CodeOwnersReport, error)
+	SetPartners(ctx context.Context, name string, partners []string) ([]string, error)
 }
 
 type TeamHandler struct {
@@ -26,8 +31,9 @@ func NewTeamHandler(teamService TeamService) *TeamHandler {
 }
 
 type CreateTeamRequest struct {
-	Name    string        `json:"team_name"`
-	Members []models.User `json:"members"`
+	Name     string              `json:"team_name"`
+	Members  []models.User       `json:"members"`
+	Settings models.TeamSettings `json:"settings"`
 }
 
 type TeamResponse struct {
@@ -42,8 +48,9 @@ func (h *TeamHandler) CreateTeam(w http.ResponseWriter, r *http.Request) {
 	}
 
 	team := models.Team{
-		Name:    req.Name,
-		Members: req.Members,
+		Name:     req.Name,
+		Members:  req.Members,
+		Settings: req.Settings,
 	}
 
 	createdTeam, err := h.teamService.CreateTeam(r.Context(), team)
@@ -51,7 +58,9 @@ func (h *TeamHandler) CreateTeam(w http.ResponseWriter, r *http.Request) {
 		switch err {
 		case models.ErrTeamExists:
 			httpErr.WriteError(w, http.StatusBadRequest, httpErr.ErrTeamExists)
-		case models.ErrTeamNameEmpty, models.ErrTeamMembersEmpty:
+		case models.ErrTeamNameEmpty, models.ErrTeamMembersEmpty,
+			models.ErrInvalidReviewStrategy, models.ErrInvalidReviewersCount,
+			models.ErrInvalidRequiredApprovals, models.ErrInvalidOverflowPolicy:
 			http.Error(w, err.Error(), http.StatusBadRequest)
 		default:
 			httpErr.WriteInernalError(w, err)
@@ -95,6 +104,107 @@ func (h *TeamHandler) GetTeam(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// SetSettingsRequest changes only the settings present in it
+type SetSettingsRequest struct {
+	Name                    string                 `json:"team_name"`
+	ReviewStrategy          *models.ReviewStrategy `json:"review_strategy,omitempty"`
+	ReviewersCount          *int                   `json:"reviewers_count,omitempty"`
+	RequiredApprovals       *int                   `json:"required_approvals,omitempty"`
+	BlockOnChangesRequested *bool                  `json:"block_on_changes_requested,omitempty"`
+	OverflowPolicy          *models.OverflowPolicy `json:"overflow_policy,omitempty"`
+}
+
+type TeamSettingsResponse struct {
+	Name     string              `json:"team_name"`
+	Settings models.TeamSettings `json:"settings"`
+}
+
+func (h *TeamHandler) SetSettings(w http.ResponseWriter, r *http.Request) {
+	var req SetSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	patch := models.TeamSettingsPatch{
+		ReviewStrategy:          req.ReviewStrategy,
+		ReviewersCount:          req.ReviewersCount,
+		RequiredApprovals:       req.RequiredApprovals,
+		BlockOnChangesRequested: req.BlockOnChangesRequested,
+		OverflowPolicy:          req.OverflowPolicy,
+	}
+
+	updated, err := h.teamService.UpdateSettings(r.Context(), req.Name, patch)
+	if err != nil {
+		switch err {
+		case models.ErrTeamNameEmpty, models.ErrInvalidReviewStrategy, models.ErrInvalidReviewersCount,
+			models.ErrInvalidRequiredApprovals, models.ErrInvalidOverflowPolicy:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case models.ErrTeamNotFound:
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(TeamSettingsResponse{Name: req.Name, Settings: updated})
+	if err != nil {
+		slog.Error("cannot encode response", "error", err, "team", req.Name)
+	}
+}
+
+type DeactivateUsersRequest struct {
+	Name    string   `json:"team_name"`
+	UserIDs []string `json:"user_ids"`
+}
+
+type DeactivateUsersResponse struct {
+	Name          string                      `json:"team_name"`
+	Deactivated   []models.User               `json:"deactivated"`
+	Reassigned    []models.ReviewReassignment `json:"reassigned_prs"`
+	NotReassigned []models.ReviewReassignment `json:"not_reassigned_prs"`
+}
+
+func (h *TeamHandler) DeactivateUsers(w http.ResponseWriter, r *http.Request) {
+	var req DeactivateUsersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	deactivated, report, err := h.teamService.DeactivateUsers(r.Context(), req.Name, req.UserIDs)
+	if err != nil {
+		switch err {
+		case models.ErrTeamNameEmpty, models.ErrEmptyUserID, models.ErrEmptyUserIDs:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case models.ErrTeamNotFound, models.ErrUserNotInTeam:
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	for i := range deactivated {
+		deactivated[i].TeamName = ""
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(DeactivateUsersResponse{
+		Name:          req.Name,
+		Deactivated:   deactivated,
+		Reassigned:    report.Reassigned,
+		NotReassigned: report.NotReassigned,
+	})
+	if err != nil {
+		slog.Error("cannot encode response", "error", err, "team", req.Name)
+	}
+}
+
 func hideTeamName(team models.Team) models.Team {
 	for i := range team.Members {
 		team.Members[i].TeamName = ""
@@ -102,3 +212,115 @@ func hideTeamName(team models.Team) models.Team {
 
 	return team
 }
+
+type CodeOwnersRequest struct {
+	Name    string `json:"team_name"`
+	Content string `json:"content"`
+}
+
+type CodeOwnersResponse struct {
+	Name  string                 `json:"team_name"`
+	Rules []models.OwnershipRule `json:"rules"`
+}
+
+type CodeOwnersReportResponse struct {
+	Name string `json:"team_name"`
+	models.CodeOwnersReport
+}
+
+// SetCodeOwners replaces CODEOWNERS rules of the team
+func (h *TeamHandler) SetCodeOwners(w http.ResponseWriter, r *http.Request) {
+	var req CodeOwnersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	rules, err := h.teamService.SetCodeOwners(r.Context(), req.Name, req.Content)
+	if err != nil {
+		switch err {
+		case models.ErrTeamNameEmpty, models.ErrInvalidCodeOwners:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case models.ErrTeamNotFound:
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(CodeOwnersResponse{Name: req.Name, Rules: rules})
+	if err != nil {
+		slog.Error("cannot encode response", "error", err, "team", req.Name)
+	}
+}
+
+// ValidateCodeOwners reports problems of CODEOWNERS content without storing it
+func (h *TeamHandler) ValidateCodeOwners(w http.ResponseWriter, r *http.Request) {
+	var req CodeOwnersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.teamService.ValidateCodeOwners(r.Context(), req.Name, req.Content)
+	if err != nil {
+		switch err {
+		case models.ErrTeamNameEmpty:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case models.ErrTeamNotFound:
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(CodeOwnersReportResponse{Name: req.Name, CodeOwnersReport: report})
+	if err != nil {
+		slog.Error("cannot encode response", "error", err, "team", req.Name)
+	}
+}
+
+type PartnersRequest struct {
+	Name         string   `json:"team_name"`
+	PartnerTeams []string `json:"partner_teams"`
+}
+
+type PartnersResponse struct {
+	Name         string   `json:"team_name"`
+	PartnerTeams []string `json:"partner_teams"`
+}
+
+// SetPartners replaces partner teams that fill missing reviewers of the team
+func (h *TeamHandler) SetPartners(w http.ResponseWriter, r *http.Request) {
+	var req PartnersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	partners, err := h.teamService.SetPartners(r.Context(), req.Name, req.PartnerTeams)
+	if err != nil {
+		switch err {
+		case models.ErrTeamNameEmpty, models.ErrPartnerTeamSelf:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case models.ErrTeamNotFound:
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(PartnersResponse{Name: req.Name, PartnerTeams: partners})
+	if err != nil {
+		slog.Error("cannot encode response", "error", err, "team", req.Name)
+	}
+}
@@ -15,8 +15,13 @@ import (
 )
 
 type mockTeamService struct {
-	createFn func(ctx context.Context, team models.Team) (models.Team, error)
-	getFn    func(ctx context.Context, name string) (models.Team, error)
+	createFn         func(ctx context.Context, team models.Team) (models.Team, error)
+	getFn            func(ctx context.Context, name string) (models.Team, error)
+	settingsFn       func(ctx context.Context, name string, patch models.TeamSettingsPatch) (models.TeamSettings, error)
+	deactiveFn       func(ctx context.Context, name string, userIDs []string) ([]models.User, models.ReassignmentReport, error)
+	setOwnersFn      func(ctx context.Context, name, content string) ([]models.OwnershipRule, error)
+	validateOwnersFn func(ctx context.Context, name, content string) (models.CodeOwnersReport, error)
+	partnersFn       func(ctx context.Context, name string, partners []string) ([]string, error)
 }
 
 func (m *mockTeamService) CreateTeam(ctx context.Context, team models.Team) (models.Team, error) {
@@ -27,6 +32,26 @@ func (m *mockTeamService) GetTeam(ctx context.Context, name string) (models.Team
 	return m.getFn(ctx, name)
 }
 
+func (m *mockTeamService) UpdateSettings(ctx context.Context, name string, patch models.TeamSettingsPatch) (models.TeamSettings, error) {
+	return m.settingsFn(ctx, name, patch)
+}
+
+func (m *mockTeamService) DeactivateUsers(ctx context.Context, name string, userIDs []string) ([]models.User, models.ReassignmentReport, error) {
+	return m.deactiveFn(ctx, name, userIDs)
+}
+
+func (m *mockTeamService) SetCodeOwners(ctx context.Context, name, content string) ([]models.OwnershipRule, error) {
+	return m.setOwnersFn(ctx, name, content)
+}
+
+func (m *mockTeamService) ValidateCodeOwners(ctx context.Context, name, content string) (models.CodeOwnersReport, error) {
+	return m.validateOwnersFn(ctx, name, content)
+}
+
+func (m *mockTeamService) SetPartners(ctx context.Context, name string, partners []string) ([]string, error) {
+	return m.partnersFn(ctx, name, partners)
+}
+
 func TestTeamHandler_CreateTeam_Success(t *testing.T) {
 	service := &mockTeamService{
 		createFn: func(ctx context.Context, team models.Team) (models.Team, error) {
@@ -178,3 +203,240 @@ func TestTeamHandler_GetTeam_NotFound(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, httpErr.ErrNotFound.Error.Code, errResp.Error.Code)
 }
+
+func TestTeamHandler_SetSettings_Success(t *testing.T) {
+	service := &mockTeamService{
+		settingsFn: func(ctx context.Context, name string, patch models.TeamSettingsPatch) (models.TeamSettings, error) {
+			assert.Equal(t, "backend", name)
+			// omitted settings are kept
+			assert.Nil(t, patch.RequiredApprovals)
+			assert.Nil(t, patch.BlockOnChangesRequested)
+			assert.Nil(t, patch.OverflowPolicy)
+			return patch.Apply(models.DefaultTeamSettings()), nil
+		},
+	}
+
+	handler := NewTeamHandler(service)
+
+	req := httptest.NewRequest(http.MethodPost, "/team/setSettings",
+		bytes.NewBufferString(`{"team_name":"backend","review_strategy":"ROUND_ROBIN","reviewers_count":3}`))
+	rec := httptest.NewRecorder()
+
+	handler.SetSettings(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var resp TeamSettingsResponse
+	err := json.NewDecoder(res.Body).Decode(&resp)
+	require.NoError(t, err)
+	assert.Equal(t, "backend", resp.Name)
+	assert.Equal(t, models.ReviewStrategyRoundRobin, resp.Settings.ReviewStrategy)
+	assert.Equal(t, 3, resp.Settings.ReviewersCount)
+}
+
+func TestTeamHandler_SetSettings_InvalidStrategy(t *testing.T) {
+	service := &mockTeamService{
+		settingsFn: func(ctx context.Context, name string, patch models.TeamSettingsPatch) (models.TeamSettings, error) {
+			return models.TeamSettings{}, models.ErrInvalidReviewStrategy
+		},
+	}
+
+	handler := NewTeamHandler(service)
+
+	req := httptest.NewRequest(http.MethodPost, "/team/setSettings",
+		bytes.NewBufferString(`{"team_name":"backend","review_strategy":"UNKNOWN","reviewers_count":2}`))
+	rec := httptest.NewRecorder()
+
+	handler.SetSettings(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestTeamHandler_DeactivateUsers_Success(t *testing.T) {
+	service := &mockTeamService{
+		deactiveFn: func(ctx context.Context, name string, userIDs []string) ([]models.User, models.ReassignmentReport, error) {
+			assert.Equal(t, []string{"u1", "u2"}, userIDs)
+			return []models.User{
+				{ID: "u1", Username: "Alice", TeamName: name},
+				{ID: "u2", Username: "Bob", TeamName: name},
+			}, models.ReassignmentReport{
+				Reassigned: []models.ReviewReassignment{
+					{PullRequestID: "pr-1", OldReviewerID: "u1", NewReviewerID: "u3"},
+				},
+				NotReassigned: []models.ReviewReassignment{},
+			}, nil
+		},
+	}
+
+	handler := NewTeamHandler(service)
+
+	payload, err := json.Marshal(DeactivateUsersRequest{Name: "backend", UserIDs: []string{"u1", "u2"}})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/team/deactivateUsers", bytes.NewReader(payload))
+	rec := httptest.NewRecorder()
+
+	handler.DeactivateUsers(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	var resp DeactivateUsersResponse
+	err = json.NewDecoder(res.Body).Decode(&resp)
+	require.NoError(t, err)
+	assert.Len(t, resp.Deactivated, 2)
+	assert.Empty(t, resp.Deactivated[0].TeamName)
+	require.Len(t, resp.Reassigned, 1)
+	assert.Equal(t, "u3", resp.Reassigned[0].NewReviewerID)
+}
+
+func TestTeamHandler_DeactivateUsers_NotInTeam(t *testing.T) {
+	service := &mockTeamService{
+		deactiveFn: func(ctx context.Context, name string, userIDs []string) ([]models.User, models.ReassignmentReport, error) {
+			return nil, models.ReassignmentReport{}, models.ErrUserNotInTeam
+		},
+	}
+
+	handler := NewTeamHandler(service)
+
+	req := httptest.NewRequest(http.MethodPost, "/team/deactivateUsers",
+		bytes.NewBufferString(`{"team_name":"backend","user_ids":["stranger"]}`))
+	rec := httptest.NewRecorder()
+
+	handler.DeactivateUsers(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func TestTeamHandler_SetCodeOwners(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "invalid content", err: models.ErrInvalidCodeOwners, wantStatus: http.StatusBadRequest},
+		{name: "team not found", err: models.ErrTeamNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &mockTeamService{
+				setOwnersFn: func(ctx context.Context, name, content string) ([]models.OwnershipRule, error) {
+					assert.Equal(t, "backend", name)
+					assert.Equal(t, "/api/ @alice", content)
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return []models.OwnershipRule{{Line: 1, Pattern: "/api/", Owners: []string{"alice"}}}, nil
+				},
+			}
+
+			handler := NewTeamHandler(service)
+
+			req := httptest.NewRequest(http.MethodPost, "/team/setCodeOwners",
+				bytes.NewBufferString(`{"team_name":"backend","content":"/api/ @alice"}`))
+			rec := httptest.NewRecorder()
+
+			handler.SetCodeOwners(rec, req)
+
+			res := rec.Result()
+			defer res.Body.Close()
+
+			require.Equal(t, tt.wantStatus, res.StatusCode)
+			if tt.err != nil {
+				return
+			}
+
+			var resp CodeOwnersResponse
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&resp))
+			assert.Equal(t, "backend", resp.Name)
+			assert.Len(t, resp.Rules, 1)
+		})
+	}
+}
+
+func TestTeamHandler_ValidateCodeOwners(t *testing.T) {
+	service := &mockTeamService{
+		validateOwnersFn: func(ctx context.Context, name, content string) (models.CodeOwnersReport, error) {
+			return models.CodeOwnersReport{
+				Rules:    []models.OwnershipRule{},
+				Problems: []models.CodeOwnersProblem{{Line: 1, Message: "owner \"@carol\" is not a member of team backend"}},
+			}, nil
+		},
+	}
+
+	handler := NewTeamHandler(service)
+
+	req := httptest.NewRequest(http.MethodPost, "/team/validateCodeOwners",
+		bytes.NewBufferString(`{"team_name":"backend","content":"* @carol"}`))
+	rec := httptest.NewRecorder()
+
+	handler.ValidateCodeOwners(rec, req)
+
+	res := rec.Result()
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var resp map[string]any
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&resp))
+	assert.Equal(t, "backend", resp["team_name"])
+	assert.Equal(t, false, resp["valid"])
+	assert.Len(t, resp["problems"], 1)
+}
+
+func TestTeamHandler_SetPartners(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "success", wantStatus: http.StatusOK},
+		{name: "own partner", err: models.ErrPartnerTeamSelf, wantStatus: http.StatusBadRequest},
+		{name: "partner not found", err: models.ErrTeamNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &mockTeamService{
+				partnersFn: func(ctx context.Context, name string, partners []string) ([]string, error) {
+					assert.Equal(t, "backend", name)
+					assert.Equal(t, []string{"platform"}, partners)
+					return partners, tt.err
+				},
+			}
+
+			handler := NewTeamHandler(service)
+
+			req := httptest.NewRequest(http.MethodPost, "/team/setPartners",
+				bytes.NewBufferString(`{"team_name":"backend","partner_teams":["platform"]}`))
+			rec := httptest.NewRecorder()
+
+			handler.SetPartners(rec, req)
+
+			res := rec.Result()
+			defer res.Body.Close()
+
+			require.Equal(t, tt.wantStatus, res.StatusCode)
+			if tt.err != nil {
+				return
+			}
+
+			var resp PartnersResponse
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&resp))
+			assert.Equal(t, PartnersResponse{Name: "backend", PartnerTeams: []string{"platform"}}, resp)
+		})
+	}
+}
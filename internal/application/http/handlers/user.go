@@ -11,8 +11,11 @@ import (
 )
 
 type UserService interface {
-	SetIsActive(ctx context.Context, userID string, isActive bool) (models.User, error)
-	GetPRs(ctx context.Context, userID string) ([]models.PullRequest, error)
+	SetIsActive(ctx context.Context, userID string, isActive bool) (models.User, models.ReassignmentReport, error)
+	SetMaxOpenReviews(ctx context.Context, userID string, limit *int) (models.User, error)
+	SetAbsence(ctx context.Context, userID string, absences []models.Absence) ([]models.Absence, error)
+	SetWorkingHours(ctx context.Context, userID string, timezone string, hours *models.WorkingHours) (models.User, error)
+	GetPRs(ctx context.Context, userID string, filter models.PRFilter) (models.PRPage, int, error)
 }
 
 type UserHandler struct {
@@ -34,6 +37,12 @@ type UserResponse struct {
 	User models.User `json:"user"`
 }
 
+type SetIsActiveResponse struct {
+	UserResponse
+	Reassigned    []models.ReviewReassignment `json:"reassigned_prs,omitempty"`
+	NotReassigned []models.ReviewReassignment `json:"not_reassigned_prs,omitempty"`
+}
+
 func (h *UserHandler) SetIsActive(w http.ResponseWriter, r *http.Request) {
 	var req SetIsActiveRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -41,7 +50,7 @@ func (h *UserHandler) SetIsActive(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	updatedUser, err := h.userService.SetIsActive(r.Context(), req.UserID, req.IsActive)
+	updatedUser, report, err := h.userService.SetIsActive(r.Context(), req.UserID, req.IsActive)
 	if err != nil {
 		switch err {
 		case models.ErrEmptyUserID:
@@ -56,7 +65,11 @@ func (h *UserHandler) SetIsActive(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	err = json.NewEncoder(w).Encode(UserResponse{User: updatedUser})
+	err = json.NewEncoder(w).Encode(SetIsActiveResponse{
+		UserResponse:  UserResponse{User: updatedUser},
+		Reassigned:    report.Reassigned,
+		NotReassigned: report.NotReassigned,
+	})
 	if err != nil {
 		slog.Error("failed to encode response", "error", err,
 			"user_id", req.UserID,
@@ -65,27 +78,157 @@ func (h *UserHandler) SetIsActive(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+type SetMaxOpenReviewsRequest struct {
+	UserID string `json:"user_id"`
+	// MaxOpenReviews is null to remove the limit
+	MaxOpenReviews *int `json:"max_open_reviews"`
+}
+
+func (h *UserHandler) SetMaxOpenReviews(w http.ResponseWriter, r *http.Request) {
+	var req SetMaxOpenReviewsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	updatedUser, err := h.userService.SetMaxOpenReviews(r.Context(), req.UserID, req.MaxOpenReviews)
+	if err != nil {
+		switch err {
+		case models.ErrEmptyUserID, models.ErrInvalidMaxOpenReviews:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case models.ErrUserNotFound:
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(UserResponse{User: updatedUser}); err != nil {
+		slog.Error("failed to encode response", "error", err, "user_id", req.UserID)
+	}
+}
+
+type SetWorkingHoursRequest struct {
+	UserID string `json:"user_id"`
+	// Timezone is an IANA timezone name, empty means UTC
+	Timezone string `json:"timezone"`
+	// WorkingHours are null to make the user available at any time
+	WorkingHours *models.WorkingHours `json:"working_hours"`
+}
+
+func (h *UserHandler) SetWorkingHours(w http.ResponseWriter, r *http.Request) {
+	var req SetWorkingHoursRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	updatedUser, err := h.userService.SetWorkingHours(r.Context(), req.UserID, req.Timezone, req.WorkingHours)
+	if err != nil {
+		switch err {
+		case models.ErrEmptyUserID, models.ErrInvalidTimezone, models.ErrInvalidWorkingHours:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case models.ErrUserNotFound:
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(UserResponse{User: updatedUser}); err != nil {
+		slog.Error("failed to encode response", "error", err, "user_id", req.UserID)
+	}
+}
+
+type SetAbsenceRequest struct {
+	UserID string `json:"user_id"`
+	// Absences replace all absences of the user, an empty list removes them
+	Absences []models.Absence `json:"absences"`
+}
+
+type SetAbsenceResponse struct {
+	UserID   string           `json:"user_id"`
+	Absences []models.Absence `json:"absences"`
+}
+
+func (h *UserHandler) SetAbsence(w http.ResponseWriter, r *http.Request) {
+	var req SetAbsenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	absences, err := h.userService.SetAbsence(r.Context(), req.UserID, req.Absences)
+	if err != nil {
+		switch err {
+		case models.ErrEmptyUserID, models.ErrInvalidAbsence, models.ErrTooManyAbsences:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case models.ErrUserNotFound:
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(w).Encode(SetAbsenceResponse{UserID: req.UserID, Absences: absences})
+	if err != nil {
+		slog.Error("failed to encode response", "error", err, "user_id", req.UserID)
+	}
+}
+
 type GetPRsResponse struct {
-	UserID string               `json:"user_id"`
-	PRs    []models.PullRequest `json:"pull_requests"`
+	UserID     string               `json:"user_id"`
+	PRs        []models.PullRequest `json:"pull_requests"`
+	NextCursor string               `json:"next_cursor,omitempty"`
+	Total      int                  `json:"total"`
 }
 
 func (h *UserHandler) GetPRs(w http.ResponseWriter, r *http.Request) {
-	userID := r.URL.Query().Get("user_id")
+	query := r.URL.Query()
+	userID := query.Get("user_id")
 
-	prs, err := h.userService.GetPRs(r.Context(), userID)
+	limit, err := parseLimit(query)
 	if err != nil {
-		httpErr.WriteInernalError(w, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	filter := models.PRFilter{
+		Status: models.PRStatus(query.Get("status")),
+		Cursor: query.Get("cursor"),
+		Limit:  limit,
+	}
+
+	page, total, err := h.userService.GetPRs(r.Context(), userID, filter)
+	if err != nil {
+		switch err {
+		case models.ErrEmptyUserID, models.ErrInvalidPRStatus,
+			models.ErrInvalidPageLimit, models.ErrInvalidCursor:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
 		return
 	}
 
-	prs = hideMergeAt(prs)
+	prs := hideMergeAt(page.PullRequests)
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	err = json.NewEncoder(w).Encode(GetPRsResponse{
-		UserID: userID,
-		PRs:    prs,
+		UserID:     userID,
+		PRs:        prs,
+		NextCursor: page.NextCursor,
+		Total:      total,
 	})
 	if err != nil {
 		slog.Error("failed to encode response", "error", err,
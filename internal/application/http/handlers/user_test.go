@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	httpErr "github.com/437d5/pr-review-manager/internal/application/http"
 	"github.com/437d5/pr-review-manager/internal/domain/models"
@@ -15,22 +16,45 @@ import (
 )
 
 type mockUserService struct {
-	setActiveFn func(ctx context.Context, userID string, isActive bool) (models.User, error)
-	getPRsFn    func(ctx context.Context, userID string) ([]models.PullRequest, error)
+	setActiveFn func(ctx context.Context, userID string, isActive bool) (models.User, models.ReassignmentReport, error)
+	getPRsFn    func(ctx context.Context, userID string, filter models.PRFilter) (models.PRPage, int, error)
+	setMaxFn    func(ctx context.Context, userID string, limit *int) (models.User, error)
+	setAbsentFn func(ctx context.Context, userID string, absences []models.Absence) ([]models.Absence, error)
+	setHoursFn  func(ctx context.Context, userID string, timezone string, hours *models.WorkingHours) (models.User, error)
 }
 
-func (m *mockUserService) SetIsActive(ctx context.Context, userID string, isActive bool) (models.User, error) {
+func (m *mockUserService) SetWorkingHours(ctx context.Context, userID string, timezone string, hours *models.WorkingHours) (models.User, error) {
+	return m.setHoursFn(ctx, userID, timezone, hours)
+}
+
+func (m *mockUserService) SetAbsence(ctx context.Context, userID string, absences []models.Absence) ([]models.Absence, error) {
+	return m.setAbsentFn(ctx, userID, absences)
+}
+
+func (m *mockUserService) SetMaxOpenReviews(ctx context.Context, userID string, limit *int) (models.User, error) {
+	return m.setMaxFn(ctx, userID, limit)
+}
+
+func (m *mockUserService) SetIsActive(ctx context.Context, userID string, isActive bool) (models.User, models.ReassignmentReport, error) {
 	return m.setActiveFn(ctx, userID, isActive)
 }
 
-func (m *mockUserService) GetPRs(ctx context.Context, userID string) ([]models.PullRequest, error) {
-	return m.getPRsFn(ctx, userID)
+func (m *mockUserService) GetPRs(ctx context.Context, userID string, filter models.PRFilter) (models.PRPage, int, error) {
+	return m.getPRsFn(ctx, userID, filter)
 }
 
 func TestUserHandler_SetIsActive_Success(t *testing.T) {
 	service := &mockUserService{
-		setActiveFn: func(ctx context.Context, userID string, isActive bool) (models.User, error) {
-			return models.User{ID: userID, Username: "Bob", TeamName: "backend", IsActive: isActive}, nil
+		setActiveFn: func(ctx context.Context, userID string, isActive bool) (models.User, models.ReassignmentReport, error) {
+			return models.User{ID: userID, Username: "Bob", TeamName: "backend", IsActive: isActive},
+				models.ReassignmentReport{
+					Reassigned: []models.ReviewReassignment{
+						{PullRequestID: "pr-1", OldReviewerID: userID, NewReviewerID: "u2"},
+					},
+					NotReassigned: []models.ReviewReassignment{
+						{PullRequestID: "pr-2", OldReviewerID: userID},
+					},
+				}, nil
 		},
 	}
 
@@ -53,18 +77,22 @@ func TestUserHandler_SetIsActive_Success(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, res.StatusCode)
 
-	var resp UserResponse
+	var resp SetIsActiveResponse
 	err = json.NewDecoder(res.Body).Decode(&resp)
 	require.NoError(t, err)
 	assert.Equal(t, reqBody.UserID, resp.User.ID)
 	assert.False(t, resp.User.IsActive)
+	require.Len(t, resp.Reassigned, 1)
+	assert.Equal(t, "u2", resp.Reassigned[0].NewReviewerID)
+	require.Len(t, resp.NotReassigned, 1)
+	assert.Equal(t, "pr-2", resp.NotReassigned[0].PullRequestID)
 }
 
 func TestUserHandler_SetIsActive_InvalidJSON(t *testing.T) {
 	service := &mockUserService{
-		setActiveFn: func(ctx context.Context, userID string, isActive bool) (models.User, error) {
+		setActiveFn: func(ctx context.Context, userID string, isActive bool) (models.User, models.ReassignmentReport, error) {
 			t.Fatal("SetIsActive should not run for invalid JSON")
-			return models.User{}, nil
+			return models.User{}, models.ReassignmentReport{}, nil
 		},
 	}
 
@@ -83,8 +111,8 @@ func TestUserHandler_SetIsActive_InvalidJSON(t *testing.T) {
 
 func TestUserHandler_SetIsActive_NotFound(t *testing.T) {
 	service := &mockUserService{
-		setActiveFn: func(ctx context.Context, userID string, isActive bool) (models.User, error) {
-			return models.User{}, models.ErrUserNotFound
+		setActiveFn: func(ctx context.Context, userID string, isActive bool) (models.User, models.ReassignmentReport, error) {
+			return models.User{}, models.ReassignmentReport{}, models.ErrUserNotFound
 		},
 	}
 
@@ -113,18 +141,179 @@ func TestUserHandler_SetIsActive_NotFound(t *testing.T) {
 	assert.Equal(t, httpErr.ErrNotFound.Error.Code, errResp.Error.Code)
 }
 
+func TestUserHandler_SetMaxOpenReviews(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		limit  *int
+		err    error
+		status int
+	}{
+		{"set limit", `{"user_id":"u1","max_open_reviews":3}`, func() *int { limit := 3; return &limit }(), nil, http.StatusOK},
+		{"remove limit", `{"user_id":"u1","max_open_reviews":null}`, nil, nil, http.StatusOK},
+		{"invalid limit", `{"user_id":"u1","max_open_reviews":0}`, func() *int { limit := 0; return &limit }(),
+			models.ErrInvalidMaxOpenReviews, http.StatusBadRequest},
+		{"user not found", `{"user_id":"u1"}`, nil, models.ErrUserNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &mockUserService{
+				setMaxFn: func(ctx context.Context, userID string, limit *int) (models.User, error) {
+					assert.Equal(t, "u1", userID)
+					assert.Equal(t, tt.limit, limit)
+					if tt.err != nil {
+						return models.User{}, tt.err
+					}
+					return models.User{ID: userID, MaxOpenReviews: limit}, nil
+				},
+			}
+			handler := NewUserHandler(service)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/setMaxOpenReviews", bytes.NewBufferString(tt.body))
+			rec := httptest.NewRecorder()
+
+			handler.SetMaxOpenReviews(rec, req)
+
+			res := rec.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tt.status, res.StatusCode)
+			if tt.status == http.StatusOK {
+				var resp UserResponse
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&resp))
+				assert.Equal(t, tt.limit, resp.User.MaxOpenReviews)
+			}
+		})
+	}
+}
+
+func TestUserHandler_SetWorkingHours(t *testing.T) {
+	dayShift := &models.WorkingHours{Start: "09:00", End: "18:00"}
+
+	tests := []struct {
+		name     string
+		body     string
+		timezone string
+		hours    *models.WorkingHours
+		err      error
+		status   int
+	}{
+		{"set working hours", `{"user_id":"u1","timezone":"Europe/Moscow","working_hours":{"start":"09:00","end":"18:00"}}`,
+			"Europe/Moscow", dayShift, nil, http.StatusOK},
+		{"remove working hours", `{"user_id":"u1","timezone":"Europe/Moscow","working_hours":null}`,
+			"Europe/Moscow", nil, nil, http.StatusOK},
+		{"unknown timezone", `{"user_id":"u1","timezone":"Mars/Olympus"}`,
+			"Mars/Olympus", nil, models.ErrInvalidTimezone, http.StatusBadRequest},
+		{"invalid hours", `{"user_id":"u1","working_hours":{"start":"9am","end":"18:00"}}`,
+			"", &models.WorkingHours{Start: "9am", End: "18:00"}, models.ErrInvalidWorkingHours, http.StatusBadRequest},
+		{"user not found", `{"user_id":"u1"}`, "", nil, models.ErrUserNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &mockUserService{
+				setHoursFn: func(ctx context.Context, userID string, timezone string, hours *models.WorkingHours) (models.User, error) {
+					assert.Equal(t, "u1", userID)
+					assert.Equal(t, tt.timezone, timezone)
+					assert.Equal(t, tt.hours, hours)
+					if tt.err != nil {
+						return models.User{}, tt.err
+					}
+					return models.User{ID: userID, Timezone: timezone, WorkingHours: hours}, nil
+				},
+			}
+			handler := NewUserHandler(service)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/setWorkingHours", bytes.NewBufferString(tt.body))
+			rec := httptest.NewRecorder()
+
+			handler.SetWorkingHours(rec, req)
+
+			res := rec.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tt.status, res.StatusCode)
+			if tt.status == http.StatusOK {
+				var resp UserResponse
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&resp))
+				assert.Equal(t, tt.timezone, resp.User.Timezone)
+				assert.Equal(t, tt.hours, resp.User.WorkingHours)
+			}
+		})
+	}
+}
+
+func TestUserHandler_SetAbsence(t *testing.T) {
+	start := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	vacation := []models.Absence{{StartsAt: start, EndsAt: start.Add(14 * 24 * time.Hour)}}
+
+	tests := []struct {
+		name     string
+		body     string
+		absences []models.Absence
+		err      error
+		status   int
+	}{
+		{"set absence", `{"user_id":"u1","absences":[{"starts_at":"2025-07-01T00:00:00Z","ends_at":"2025-07-15T00:00:00Z"}]}`,
+			vacation, nil, http.StatusOK},
+		{"clear absences", `{"user_id":"u1","absences":[]}`, []models.Absence{}, nil, http.StatusOK},
+		{"invalid range", `{"user_id":"u1","absences":[{"starts_at":"2025-07-15T00:00:00Z","ends_at":"2025-07-01T00:00:00Z"}]}`,
+			[]models.Absence{{StartsAt: start.Add(14 * 24 * time.Hour), EndsAt: start}}, models.ErrInvalidAbsence, http.StatusBadRequest},
+		{"user not found", `{"user_id":"u1","absences":[]}`, []models.Absence{}, models.ErrUserNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &mockUserService{
+				setAbsentFn: func(ctx context.Context, userID string, absences []models.Absence) ([]models.Absence, error) {
+					assert.Equal(t, "u1", userID)
+					assert.Equal(t, tt.absences, absences)
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return absences, nil
+				},
+			}
+			handler := NewUserHandler(service)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/setAbsence", bytes.NewBufferString(tt.body))
+			rec := httptest.NewRecorder()
+
+			handler.SetAbsence(rec, req)
+
+			res := rec.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tt.status, res.StatusCode)
+			if tt.status == http.StatusOK {
+				var resp SetAbsenceResponse
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&resp))
+				assert.Equal(t, "u1", resp.UserID)
+				assert.Equal(t, tt.absences, resp.Absences)
+			}
+		})
+	}
+}
+
 func TestUserHandler_GetPRs_Success(t *testing.T) {
 	service := &mockUserService{
-		getPRsFn: func(ctx context.Context, userID string) ([]models.PullRequest, error) {
-			return []models.PullRequest{
-				{ID: "pr-1", Name: "Feature", AuthorID: "u2", Status: models.PRStatusOpen},
-			}, nil
+		getPRsFn: func(ctx context.Context, userID string, filter models.PRFilter) (models.PRPage, int, error) {
+			assert.Equal(t, models.PRStatusOpen, filter.Status)
+			assert.Equal(t, 1, filter.Limit)
+			assert.Equal(t, "abc", filter.Cursor)
+			return models.PRPage{
+				PullRequests: []models.PullRequest{
+					{ID: "pr-1", Name: "Feature", AuthorID: "u2", Status: models.PRStatusOpen},
+				},
+				NextCursor: "next",
+			}, 5, nil
 		},
 	}
 
 	handler := NewUserHandler(service)
 
-	req := httptest.NewRequest(http.MethodGet, "/users/getReview?user_id=u1", nil)
+	req := httptest.NewRequest(http.MethodGet, "/users/getReview?user_id=u1&status=OPEN&limit=1&cursor=abc", nil)
 	rec := httptest.NewRecorder()
 
 	handler.GetPRs(rec, req)
@@ -139,12 +328,31 @@ func TestUserHandler_GetPRs_Success(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "u1", resp.UserID)
 	assert.Len(t, resp.PRs, 1)
+	assert.Equal(t, "next", resp.NextCursor)
+	assert.Equal(t, 5, resp.Total)
+}
+
+func TestUserHandler_GetPRs_InvalidStatus(t *testing.T) {
+	service := &mockUserService{
+		getPRsFn: func(ctx context.Context, userID string, filter models.PRFilter) (models.PRPage, int, error) {
+			return models.PRPage{}, 0, models.ErrInvalidPRStatus
+		},
+	}
+
+	handler := NewUserHandler(service)
+
+	req := httptest.NewRequest(http.MethodGet, "/users/getReview?user_id=u1&status=REVIEWED", nil)
+	rec := httptest.NewRecorder()
+
+	handler.GetPRs(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
 }
 
 func TestUserHandler_GetPRs_InternalError(t *testing.T) {
 	service := &mockUserService{
-		getPRsFn: func(ctx context.Context, userID string) ([]models.PullRequest, error) {
-			return nil, assert.AnError
+		getPRsFn: func(ctx context.Context, userID string, filter models.PRFilter) (models.PRPage, int, error) {
+			return models.PRPage{}, 0, assert.AnError
 		},
 	}
 
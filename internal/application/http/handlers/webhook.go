@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	httpErr "github.com/437d5/pr-review-manager/internal/application/http"
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+)
+
+// WebhookPRService is a part of PRService driven by code host webhooks
+type WebhookPRService interface {
+	CreatePR(ctx context.Context, pr models.PullRequest) (models.PullRequest, error)
+	Merge(ctx context.Context, id string, force bool) (models.PullRequest, error)
+	MarkReady(ctx context.Context, id string) (models.PullRequest, error)
+	Close(ctx context.Context, id string) (models.PullRequest, error)
+	Reopen(ctx context.Context, id string) (models.PullRequest, error)
+}
+
+type WebhookHandler struct {
+	prService    WebhookPRService
+	githubSecret []byte
+	gitlabToken  []byte
+}
+
+func NewWebhookHandler(prService WebhookPRService, githubSecret, gitlabToken string) *WebhookHandler {
+	return &WebhookHandler{
+		prService:    prService,
+		githubSecret: []byte(githubSecret),
+		gitlabToken:  []byte(gitlabToken),
+	}
+}
+
+type prAction string
+
+const (
+	prActionOpen   prAction = "open"
+	prActionReady  prAction = "ready"
+	prActionMerge  prAction = "merge"
+	prActionClose  prAction = "close"
+	prActionReopen prAction = "reopen"
+)
+
+const (
+	webhookStatusApplied = "applied"
+	webhookStatusIgnored = "ignored"
+)
+
+type WebhookResponse struct {
+	Status      string              `json:"status"`
+	PullRequest *models.PullRequest `json:"pr,omitempty"`
+}
+
+// applyPRAction maps PR change reported by a code host onto PRService,
+// pr must have ID set and Name, AuthorID and Status for open action
+func (h *WebhookHandler) applyPRAction(w http.ResponseWriter, r *http.Request, action prAction, pr models.PullRequest) {
+	ctx := r.Context()
+
+	var result models.PullRequest
+	var err error
+	switch action {
+	case prActionOpen:
+		result, err = h.prService.CreatePR(ctx, pr)
+	case prActionReady:
+		result, err = h.prService.MarkReady(ctx, pr.ID)
+	case prActionMerge:
+		// PR is already merged on the code host, bypass of merge rules is recorded
+		result, err = h.prService.Merge(ctx, pr.ID, false)
+		if errors.Is(err, models.ErrMergeRequirementsNotMet) {
+			slog.Warn("PR merged on code host without meeting merge rules", "pr_id", pr.ID)
+			result, err = h.prService.Merge(ctx, pr.ID, true)
+		}
+	case prActionClose:
+		result, err = h.prService.Close(ctx, pr.ID)
+	case prActionReopen:
+		result, err = h.prService.Reopen(ctx, pr.ID)
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrPullRequestExists), errors.Is(err, models.ErrPullRequestAlreadyMerged),
+			errors.Is(err, models.ErrPullRequestStatusSame):
+			// redelivered webhook
+			writeWebhookResponse(w, WebhookResponse{Status: webhookStatusIgnored})
+		case errors.Is(err, models.ErrPullRequestIDEmpty), errors.Is(err, models.ErrPullRequestNameEmpty),
+			errors.Is(err, models.ErrPullRequestAuthorIDEmpty), errors.Is(err, models.ErrEmptyUserID),
+			errors.Is(err, models.ErrRequestedReviewerIsAuthor), errors.Is(err, models.ErrRequestedReviewerInactive),
+			errors.Is(err, models.ErrTooManyRequestedReviewers):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case errors.Is(err, models.ErrUserNotFound), errors.Is(err, models.ErrTeamNotFound),
+			errors.Is(err, models.ErrPullRequestNotFound):
+			httpErr.WriteError(w, http.StatusNotFound, httpErr.ErrNotFound)
+		case errors.Is(err, models.ErrPullRequestNotOpen):
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrPRNotOpen)
+		case errors.Is(err, models.ErrInvalidStatusTransition):
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrInvalidTransition)
+		case errors.Is(err, models.ErrReviewersAtCapacity):
+			// team rejects PRs while its reviewers are at capacity, code host may redeliver later
+			httpErr.WriteError(w, http.StatusConflict, httpErr.ErrReviewersAtCapacity)
+		default:
+			httpErr.WriteInernalError(w, err)
+		}
+		return
+	}
+
+	slog.Info("code host PR event applied", "pr_id", pr.ID, "action", action, "status", result.Status)
+	writeWebhookResponse(w, WebhookResponse{Status: webhookStatusApplied, PullRequest: &result})
+}
+
+func writeWebhookResponse(w http.ResponseWriter, res WebhookResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		slog.Error("failed to encode response", "error", err.Error())
+	}
+}
@@ -10,26 +10,55 @@ func InitRouter(
 	teamHandler *handlers.TeamHandler,
 	userHandler *handlers.UserHandler,
 	prHandler *handlers.PRHandler,
+	statsHandler *handlers.StatsHandler,
+	webhookHandler *handlers.WebhookHandler,
 ) *http.ServeMux {
 	mainRouter := http.NewServeMux()
 
 	prRouter := http.NewServeMux()
 	teamRouter := http.NewServeMux()
 	userRouter := http.NewServeMux()
+	statsRouter := http.NewServeMux()
+	webhookRouter := http.NewServeMux()
 
 	prRouter.HandleFunc("POST /create", prHandler.CreatePR)
+	prRouter.HandleFunc("GET /get", prHandler.GetPR)
+	prRouter.HandleFunc("GET /history", prHandler.GetHistory)
+	prRouter.HandleFunc("GET /list", prHandler.ListPRs)
 	prRouter.HandleFunc("POST /merge", prHandler.Merge)
 	prRouter.HandleFunc("POST /reassign", prHandler.Reassign)
+	prRouter.HandleFunc("POST /addReviewer", prHandler.AddReviewer)
+	prRouter.HandleFunc("POST /removeReviewer", prHandler.RemoveReviewer)
+	prRouter.HandleFunc("POST /review", prHandler.Review)
+	prRouter.HandleFunc("POST /ready", prHandler.MarkReady)
+	prRouter.HandleFunc("POST /close", prHandler.Close)
+	prRouter.HandleFunc("POST /reopen", prHandler.Reopen)
 
 	userRouter.HandleFunc("POST /setIsActive", userHandler.SetIsActive)
+	userRouter.HandleFunc("POST /setMaxOpenReviews", userHandler.SetMaxOpenReviews)
+	userRouter.HandleFunc("POST /setAbsence", userHandler.SetAbsence)
+	userRouter.HandleFunc("POST /setWorkingHours", userHandler.SetWorkingHours)
 	userRouter.HandleFunc("GET /getReview", userHandler.GetPRs)
 
 	teamRouter.HandleFunc("POST /add", teamHandler.CreateTeam)
 	teamRouter.HandleFunc("GET /get", teamHandler.GetTeam)
+	teamRouter.HandleFunc("POST /setSettings", teamHandler.SetSettings)
+	teamRouter.HandleFunc("POST /deactivateUsers", teamHandler.DeactivateUsers)
+	teamRouter.HandleFunc("POST /setCodeOwners", teamHandler.SetCodeOwners)
+	teamRouter.HandleFunc("POST /validateCodeOwners", teamHandler.ValidateCodeOwners)
+	teamRouter.HandleFunc("POST /setPartners", teamHandler.SetPartners)
+
+	statsRouter.HandleFunc("GET /reviewers", statsHandler.GetReviewerStats)
+	statsRouter.HandleFunc("GET /team", statsHandler.GetTeamStats)
+
+	webhookRouter.HandleFunc("POST /github", webhookHandler.GitHub)
+	webhookRouter.HandleFunc("POST /gitlab", webhookHandler.GitLab)
 
 	mainRouter.Handle("/team/", http.StripPrefix("/team", teamRouter))
 	mainRouter.Handle("/users/", http.StripPrefix("/users", userRouter))
 	mainRouter.Handle("/pullRequest/", http.StripPrefix("/pullRequest", prRouter))
+	mainRouter.Handle("/stats/", http.StripPrefix("/stats", statsRouter))
+	mainRouter.Handle("/webhooks/", http.StripPrefix("/webhooks", webhookRouter))
 
 	return mainRouter
 }
@@ -11,7 +11,7 @@ import (
 )
 
 func TestInitRouter_RoutePrefixes(t *testing.T) {
-	router := InitRouter(&handlers.TeamHandler{}, &handlers.UserHandler{}, &handlers.PRHandler{})
+	router := InitRouter(&handlers.TeamHandler{}, &handlers.UserHandler{}, &handlers.PRHandler{}, &handlers.StatsHandler{}, &handlers.WebhookHandler{})
 
 	tests := []struct {
 		name          string
@@ -21,11 +21,32 @@ func TestInitRouter_RoutePrefixes(t *testing.T) {
 	}{
 		{name: "team add", method: http.MethodPost, path: "/team/add", expectedRoute: "/team/"},
 		{name: "team get", method: http.MethodGet, path: "/team/get?team_name=backend", expectedRoute: "/team/"},
+		{name: "team set settings", method: http.MethodPost, path: "/team/setSettings", expectedRoute: "/team/"},
+		{name: "team deactivate users", method: http.MethodPost, path: "/team/deactivateUsers", expectedRoute: "/team/"},
+		{name: "team set code owners", method: http.MethodPost, path: "/team/setCodeOwners", expectedRoute: "/team/"},
+		{name: "team validate code owners", method: http.MethodPost, path: "/team/validateCodeOwners", expectedRoute: "/team/"},
+		{name: "team set partners", method: http.MethodPost, path: "/team/setPartners", expectedRoute: "/team/"},
 		{name: "user set active", method: http.MethodPost, path: "/users/setIsActive", expectedRoute: "/users/"},
+		{name: "user set max open reviews", method: http.MethodPost, path: "/users/setMaxOpenReviews", expectedRoute: "/users/"},
+		{name: "user set absence", method: http.MethodPost, path: "/users/setAbsence", expectedRoute: "/users/"},
+		{name: "user set working hours", method: http.MethodPost, path: "/users/setWorkingHours", expectedRoute: "/users/"},
 		{name: "user get review", method: http.MethodGet, path: "/users/getReview?user_id=u1", expectedRoute: "/users/"},
 		{name: "pr create", method: http.MethodPost, path: "/pullRequest/create", expectedRoute: "/pullRequest/"},
+		{name: "pr get", method: http.MethodGet, path: "/pullRequest/get", expectedRoute: "/pullRequest/"},
+		{name: "pr history", method: http.MethodGet, path: "/pullRequest/history", expectedRoute: "/pullRequest/"},
+		{name: "pr list", method: http.MethodGet, path: "/pullRequest/list", expectedRoute: "/pullRequest/"},
 		{name: "pr merge", method: http.MethodPost, path: "/pullRequest/merge", expectedRoute: "/pullRequest/"},
 		{name: "pr reassign", method: http.MethodPost, path: "/pullRequest/reassign", expectedRoute: "/pullRequest/"},
+		{name: "pr add reviewer", method: http.MethodPost, path: "/pullRequest/addReviewer", expectedRoute: "/pullRequest/"},
+		{name: "pr remove reviewer", method: http.MethodPost, path: "/pullRequest/removeReviewer", expectedRoute: "/pullRequest/"},
+		{name: "pr review", method: http.MethodPost, path: "/pullRequest/review", expectedRoute: "/pullRequest/"},
+		{name: "pr ready", method: http.MethodPost, path: "/pullRequest/ready", expectedRoute: "/pullRequest/"},
+		{name: "pr close", method: http.MethodPost, path: "/pullRequest/close", expectedRoute: "/pullRequest/"},
+		{name: "pr reopen", method: http.MethodPost, path: "/pullRequest/reopen", expectedRoute: "/pullRequest/"},
+		{name: "stats reviewers", method: http.MethodGet, path: "/stats/reviewers", expectedRoute: "/stats/"},
+		{name: "stats team", method: http.MethodGet, path: "/stats/team?team_name=backend", expectedRoute: "/stats/"},
+		{name: "github webhook", method: http.MethodPost, path: "/webhooks/github", expectedRoute: "/webhooks/"},
+		{name: "gitlab webhook", method: http.MethodPost, path: "/webhooks/gitlab", expectedRoute: "/webhooks/"},
 	}
 
 	for _, tt := range tests {
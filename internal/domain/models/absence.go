@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// MaxAbsencesCount limits number of absences stored for a single user
+const MaxAbsencesCount = 50
+
+// Absence is a period when the user is out of office and gets no reviews,
+// it starts at StartsAt and ends right before EndsAt
+type Absence struct {
+	ID       int64     `json:"-"`
+	UserID   string    `json:"-"`
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+}
+
+func (a Absence) Validate() error {
+	if a.StartsAt.IsZero() || a.EndsAt.IsZero() || !a.EndsAt.After(a.StartsAt) {
+		return ErrInvalidAbsence
+	}
+	return nil
+}
+
+// Covers reports whether the user is absent at t
+func (a Absence) Covers(t time.Time) bool {
+	return !t.Before(a.StartsAt) && t.Before(a.EndsAt)
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAbsence_Validate(t *testing.T) {
+	start := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		absence Absence
+		wantErr error
+	}{
+		{name: "valid", absence: Absence{StartsAt: start, EndsAt: start.Add(24 * time.Hour)}},
+		{name: "empty", absence: Absence{}, wantErr: ErrInvalidAbsence},
+		{name: "no end", absence: Absence{StartsAt: start}, wantErr: ErrInvalidAbsence},
+		{name: "empty range", absence: Absence{StartsAt: start, EndsAt: start}, wantErr: ErrInvalidAbsence},
+		{name: "ends before start", absence: Absence{StartsAt: start, EndsAt: start.Add(-time.Hour)}, wantErr: ErrInvalidAbsence},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.absence.Validate())
+		})
+	}
+}
+
+func TestAbsence_Covers(t *testing.T) {
+	start := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	absence := Absence{StartsAt: start, EndsAt: start.Add(48 * time.Hour)}
+
+	assert.False(t, absence.Covers(start.Add(-time.Second)))
+	assert.True(t, absence.Covers(start))
+	assert.True(t, absence.Covers(start.Add(24*time.Hour)))
+	assert.False(t, absence.Covers(start.Add(48*time.Hour)))
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CodeHostPR points to a PR on the code host it was imported from
+type CodeHostPR struct {
+	// Repository is "owner/name"
+	Repository string
+	Number     int
+}
+
+// GitHubPRID returns id of the PR imported from GitHub, e.g. "octo/app#42"
+func GitHubPRID(repository string, number int) string {
+	return fmt.Sprintf("%s#%d", repository, number)
+}
+
+// GitLabPRID returns id of the merge request imported from GitLab, e.g. "octo/app!7"
+func GitLabPRID(project string, iid int) string {
+	return fmt.Sprintf("%s!%d", project, iid)
+}
+
+// ParseGitHubPRID is the reverse of GitHubPRID,
+// ok is false for PRs that were not imported from GitHub
+func ParseGitHubPRID(id string) (CodeHostPR, bool) {
+	i := strings.LastIndexByte(id, '#')
+	if i < 0 {
+		return CodeHostPR{}, false
+	}
+
+	repository := id[:i]
+	owner, name, ok := strings.Cut(repository, "/")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return CodeHostPR{}, false
+	}
+
+	number, err := strconv.Atoi(id[i+1:])
+	if err != nil || number <= 0 {
+		return CodeHostPR{}, false
+	}
+
+	return CodeHostPR{Repository: repository, Number: number}, true
+}
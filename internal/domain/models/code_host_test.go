@@ -0,0 +1,30 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGitHubPRID(t *testing.T) {
+	tests := []struct {
+		id       string
+		expected CodeHostPR
+		ok       bool
+	}{
+		{id: GitHubPRID("octo/app", 42), expected: CodeHostPR{Repository: "octo/app", Number: 42}, ok: true},
+		{id: "pr-1001"},
+		{id: "octo#42"},
+		{id: "octo/app#"},
+		{id: "octo/app#-1"},
+		{id: GitLabPRID("group/sub/app", 7)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			pr, ok := ParseGitHubPRID(tt.id)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, pr)
+		})
+	}
+}
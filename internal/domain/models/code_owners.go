@@ -0,0 +1,191 @@
+package models
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// OwnershipRule is a line of CODEOWNERS file of a team,
+// when several rules match a path the last one wins
+type OwnershipRule struct {
+	Line    int      `json:"line"`
+	Pattern string   `json:"pattern"`
+	Owners  []string `json:"owners"`
+}
+
+// CodeOwnersProblem is an error on a line of CODEOWNERS file
+type CodeOwnersProblem struct {
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+// CodeOwnersReport is a result of CODEOWNERS validation
+type CodeOwnersReport struct {
+	Valid    bool                `json:"valid"`
+	Rules    []OwnershipRule     `json:"rules"`
+	Problems []CodeOwnersProblem `json:"problems"`
+}
+
+// ParseCodeOwners parses CODEOWNERS file where owners are user ids prefixed with "@",
+// e.g. "/api/ @alice @bob". All problems are returned, not only the first one.
+func ParseCodeOwners(content string) ([]OwnershipRule, []CodeOwnersProblem) {
+	rules := []OwnershipRule{}
+	problems := []CodeOwnersProblem{}
+
+	for i, line := range strings.Split(content, "\n") {
+		lineNumber := i + 1
+		if comment := strings.IndexByte(line, '#'); comment >= 0 {
+			line = line[:comment]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		pattern := fields[0]
+		if _, err := compileOwnershipPattern(pattern); err != nil {
+			problems = append(problems, CodeOwnersProblem{Line: lineNumber, Message: err.Error()})
+			continue
+		}
+
+		rule := OwnershipRule{Line: lineNumber, Pattern: pattern, Owners: []string{}}
+		valid := true
+		for _, owner := range fields[1:] {
+			userID, ok := strings.CutPrefix(owner, "@")
+			if !ok || userID == "" || strings.Contains(userID, "/") {
+				problems = append(problems, CodeOwnersProblem{
+					Line:    lineNumber,
+					Message: fmt.Sprintf("owner %q must be a user id prefixed with @", owner),
+				})
+				valid = false
+				continue
+			}
+			rule.Owners = append(rule.Owners, userID)
+		}
+
+		if valid {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules, problems
+}
+
+// MatchOwners returns owners of the paths in order of their first appearance
+func MatchOwners(rules []OwnershipRule, paths []string) []string {
+	patterns := make([]*regexp.Regexp, len(rules))
+	for i, rule := range rules {
+		// rules are validated when they are stored
+		patterns[i], _ = compileOwnershipPattern(rule.Pattern)
+	}
+
+	owners := []string{}
+	seen := make(map[string]struct{})
+	for _, p := range paths {
+		p = NormalizePath(p)
+
+		var matched *OwnershipRule
+		for i := range rules {
+			if patterns[i] != nil && patterns[i].MatchString(p) {
+				matched = &rules[i]
+			}
+		}
+		if matched == nil {
+			continue
+		}
+
+		for _, owner := range matched.Owners {
+			if _, ok := seen[owner]; ok {
+				continue
+			}
+			seen[owner] = struct{}{}
+			owners = append(owners, owner)
+		}
+	}
+
+	return owners
+}
+
+// NormalizePath returns path relative to the repository root, e.g. "./api/x.go" becomes "api/x.go"
+func NormalizePath(p string) string {
+	return strings.TrimLeft(path.Clean("/"+strings.TrimSpace(p)), "/")
+}
+
+// NormalizePaths normalizes paths and drops empty and repeated ones
+func NormalizePaths(paths []string) []string {
+	var res []string
+	seen := make(map[string]struct{}, len(paths))
+	for _, p := range paths {
+		p = NormalizePath(p)
+		if p == "" {
+			continue
+		}
+		if _, ok := seen[p]; ok {
+			continue
+		}
+		seen[p] = struct{}{}
+		res = append(res, p)
+	}
+	return res
+}
+
+// compileOwnershipPattern converts CODEOWNERS pattern to a regexp matching paths
+// relative to the repository root. Patterns follow gitignore rules except for
+// negation and character ranges, which CODEOWNERS does not support.
+func compileOwnershipPattern(pattern string) (*regexp.Regexp, error) {
+	if strings.HasPrefix(pattern, "!") {
+		return nil, fmt.Errorf("negated pattern %q is not supported", pattern)
+	}
+	if strings.ContainsAny(pattern, "[]") {
+		return nil, fmt.Errorf("character ranges in pattern %q are not supported", pattern)
+	}
+
+	p := pattern
+	dirOnly := strings.HasSuffix(p, "/")
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return nil, fmt.Errorf("pattern %q matches nothing", pattern)
+	}
+	// pattern with a slash other than the trailing one is relative to the root
+	anchored := strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+
+	var expr strings.Builder
+	expr.WriteString("^")
+	if !anchored {
+		expr.WriteString("(?:.*/)?")
+	}
+
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			expr.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			expr.WriteString(".*")
+			i++
+		case p[i] == '*':
+			expr.WriteString("[^/]*")
+		case p[i] == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(p[i : i+1]))
+		}
+	}
+
+	// pattern matches a file or everything inside of a directory,
+	// a wildcard in the last segment matches only direct children, e.g. "docs/*"
+	last := p[strings.LastIndexByte(p, '/')+1:]
+	switch {
+	case dirOnly:
+		expr.WriteString("/.*$")
+	case strings.Contains(last, "*") && last != "**":
+		expr.WriteString("$")
+	default:
+		expr.WriteString("(?:/.*)?$")
+	}
+
+	return regexp.Compile(expr.String())
+}
@@ -0,0 +1,115 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCodeOwners(t *testing.T) {
+	content := `# default owners
+*       @lead
+
+/api/   @alice @bob # api team
+docs/** @carol
+*.sql
+`
+
+	rules, problems := ParseCodeOwners(content)
+
+	assert.Empty(t, problems)
+	assert.Equal(t, []OwnershipRule{
+		{Line: 2, Pattern: "*", Owners: []string{"lead"}},
+		{Line: 4, Pattern: "/api/", Owners: []string{"alice", "bob"}},
+		{Line: 5, Pattern: "docs/**", Owners: []string{"carol"}},
+		{Line: 6, Pattern: "*.sql", Owners: []string{}},
+	}, rules)
+}
+
+func TestParseCodeOwners_Problems(t *testing.T) {
+	content := `!vendor/ @alice
+[ab].go @alice
+/api/ alice @org/team
+/web/ @bob
+`
+
+	rules, problems := ParseCodeOwners(content)
+
+	assert.Equal(t, []OwnershipRule{{Line: 4, Pattern: "/web/", Owners: []string{"bob"}}}, rules)
+	require.Len(t, problems, 4)
+	assert.Equal(t, 1, problems[0].Line)
+	assert.Equal(t, 2, problems[1].Line)
+	assert.Equal(t, 3, problems[2].Line)
+	assert.Equal(t, 3, problems[3].Line)
+}
+
+func TestOwnershipPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"*", "any/file.go", true},
+		{"*.go", "main.go", true},
+		{"*.go", "cmd/app/main.go", true},
+		{"*.go", "main.golang", false},
+		{"/api/", "api/handler.go", true},
+		{"/api/", "internal/api/handler.go", false},
+		{"api/", "internal/api/handler.go", true},
+		{"api", "api", true},
+		{"docs/*", "docs/readme.md", true},
+		{"docs/*", "docs/guide/intro.md", false},
+		{"docs/", "docs/guide/intro.md", true},
+		{"/docs/*.md", "docs/guide/intro.md", false},
+		{"docs/**/intro.md", "docs/intro.md", true},
+		{"docs/**/intro.md", "docs/guide/v1/intro.md", true},
+		{"build/logs/", "build/logs/today.log", true},
+		{"build/logs/", "src/build/logs/today.log", false},
+		{"?.txt", "a.txt", true},
+		{"?.txt", "ab.txt", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			re, err := compileOwnershipPattern(tt.pattern)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, re.MatchString(tt.path))
+		})
+	}
+}
+
+func TestMatchOwners(t *testing.T) {
+	rules, problems := ParseCodeOwners(`
+*        @lead
+/api/    @alice @bob
+/api/v2/ @carol
+*.sql
+`)
+	require.Empty(t, problems)
+
+	tests := []struct {
+		name  string
+		paths []string
+		want  []string
+	}{
+		{"last matching rule wins", []string{"api/v2/users.go"}, []string{"carol"}},
+		{"owners of several paths", []string{"./api/users.go", "/api/v2/users.go", "README.md"}, []string{"alice", "bob", "carol", "lead"}},
+		{"rule without owners", []string{"db/schema.sql"}, []string{}},
+		{"no paths", nil, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchOwners(rules, tt.paths))
+		})
+	}
+}
+
+func TestNormalizePaths(t *testing.T) {
+	assert.Equal(t,
+		[]string{"api/x.go", "docs/readme.md"},
+		NormalizePaths([]string{"./api/x.go", "", "/api/x.go", " docs//readme.md "}),
+	)
+}
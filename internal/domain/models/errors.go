@@ -7,9 +7,23 @@ var (
 	ErrTeamNameEmpty    = errors.New("team_name cannot be empty")
 	ErrTeamMembersEmpty = errors.New("team_members cannot be empty")
 	ErrTeamNotFound     = errors.New("team not found")
+	ErrPartnerTeamSelf  = errors.New("team cannot be a partner of itself")
 
-	ErrUserNotFound = errors.New("user not found")
-	ErrEmptyUserID  = errors.New("user id cannot be empty")
+	ErrInvalidReviewStrategy    = errors.New("review_strategy must be one of RANDOM, ROUND_ROBIN, LEAST_LOADED, WEIGHTED")
+	ErrInvalidReviewersCount    = errors.New("reviewers_count must be between 1 and 10")
+	ErrInvalidRequiredApprovals = errors.New("required_approvals must be between 0 and reviewers_count")
+	ErrInvalidOverflowPolicy    = errors.New("overflow_policy must be one of ASSIGN_ANYWAY, ASSIGN_FEWER, REJECT")
+
+	ErrUserNotFound  = errors.New("user not found")
+	ErrEmptyUserID   = errors.New("user id cannot be empty")
+	ErrEmptyUserIDs  = errors.New("user_ids cannot be empty")
+	ErrUserNotInTeam = errors.New("user is not a member of the team")
+
+	ErrInvalidMaxOpenReviews = errors.New("max_open_reviews must be positive")
+	ErrInvalidAbsence        = errors.New("absence must have starts_at before ends_at")
+	ErrTooManyAbsences       = errors.New("too many absences")
+	ErrInvalidTimezone       = errors.New("timezone must be an IANA timezone name")
+	ErrInvalidWorkingHours   = errors.New("working hours must be different HH:MM times")
 
 	ErrPullRequestIDEmpty       = errors.New("pull_request_id cannot be empty")
 	ErrPullRequestNameEmpty     = errors.New("pull_request_name cannot be empty")
@@ -17,7 +31,37 @@ var (
 	ErrPullRequestNotFound      = errors.New("pr not found")
 	ErrPullRequestExists        = errors.New("pr already exists")
 	ErrPullRequestAlreadyMerged = errors.New("pr already merged")
+	ErrMergeRequirementsNotMet  = errors.New("pr does not meet merge requirements")
+	ErrPullRequestNotOpen       = errors.New("pr is not open")
+	ErrInvalidStatusTransition  = errors.New("pr status transition is not allowed")
+	ErrPullRequestStatusSame    = errors.New("pr already has this status")
+
+	ErrInvalidPRStatus   = errors.New("status must be one of DRAFT, OPEN, MERGED, CLOSED")
+	ErrInvalidPageLimit  = errors.New("limit must be between 1 and 500")
+	ErrInvalidCursor     = errors.New("invalid cursor")
+	ErrInvalidTimeRange  = errors.New("time range start must not be after its end")
+	ErrInvalidWeeks      = errors.New("weeks must be between 1 and 104")
+	ErrInvalidCodeOwners = errors.New("codeowners content is invalid")
+
+	// publish error wrapping it is not retried
+	ErrPermanentDelivery = errors.New("delivery failed permanently")
+	// publish error wrapping it is retried later without using up an attempt
+	ErrDeliveryPostponed = errors.New("delivery postponed")
+
+	ErrRequestedReviewerIsAuthor = errors.New("author cannot be requested as a reviewer")
+	ErrRequestedReviewerInactive = errors.New("requested reviewer is not active")
+	ErrTooManyRequestedReviewers = errors.New("requested_reviewers cannot contain more than 10 users")
+	ErrReviewerRequested         = errors.New("reviewer was requested by the author, force is required to replace")
+
+	ErrReviewerIsAuthor        = errors.New("author cannot be a reviewer")
+	ErrReviewerInactive        = errors.New("reviewer is not active")
+	ErrReviewerNotInTeam       = errors.New("reviewer is not a member of the author's team or its partners")
+	ErrReviewerAlreadyAssigned = errors.New("user is already a reviewer of pr")
+	ErrTooManyReviewers        = errors.New("pr already has reviewers_count reviewers of the team")
 
 	ErrNoCandidateToReassign = errors.New("no candidates to reassign pr")
+	ErrReviewersAtCapacity   = errors.New("candidate reviewers are at capacity")
+	ErrNotReassignCandidate  = errors.New("new reviewer must be an active teammate of the old reviewer who is not the author or a reviewer of pr")
 	ErrUserNotReviewer       = errors.New("user is not a reviewer of pr")
+	ErrInvalidReviewDecision = errors.New("decision must be one of APPROVED, CHANGES_REQUESTED, COMMENTED")
 )
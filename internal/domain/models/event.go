@@ -0,0 +1,37 @@
+package models
+
+type AssignmentEventType string
+
+const (
+	EventPRCreated          AssignmentEventType = "PR_CREATED"
+	EventReviewerAssigned   AssignmentEventType = "REVIEWER_ASSIGNED"
+	EventReviewerReassigned AssignmentEventType = "REVIEWER_REASSIGNED"
+	EventReviewerRemoved    AssignmentEventType = "REVIEWER_REMOVED"
+	EventPRMerged           AssignmentEventType = "PR_MERGED"
+	EventUserActivated      AssignmentEventType = "USER_ACTIVATED"
+	EventUserDeactivated    AssignmentEventType = "USER_DEACTIVATED"
+)
+
+// Reasons of reassignments made by the service itself
+const (
+	ReasonUserDeactivated = "user_deactivated"
+	ReasonTeamDeactivated = "team_members_deactivated"
+	ReasonForceMerged     = "force_merged"
+	ReasonUserAbsent      = "user_absent"
+	// reviewer assigned on request of the author
+	ReasonRequested = "requested_by_author"
+)
+
+// AssignmentEvent is an entry of the append-only audit log.
+// UserID is the assigned or removed reviewer, the new reviewer on reassignment
+// or the (de)activated user, OldUserID is set on reassignment only.
+type AssignmentEvent struct {
+	ID            int64               `json:"id"`
+	Type          AssignmentEventType `json:"type"`
+	PullRequestID string              `json:"pull_request_id,omitempty"`
+	UserID        string              `json:"user_id,omitempty"`
+	OldUserID     string              `json:"old_user_id,omitempty"`
+	Reason        string              `json:"reason,omitempty"`
+	ActorID       string              `json:"actor_id,omitempty"`
+	CreatedAt     string              `json:"created_at"`
+}
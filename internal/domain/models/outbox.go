@@ -0,0 +1,123 @@
+package models
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// Topics of domain events published through the outbox
+const (
+	TopicPRCreated          = "pr.created"
+	TopicReviewerAssigned   = "reviewer.assigned"
+	TopicReviewerReassigned = "reviewer.reassigned"
+	TopicReviewerRemoved    = "reviewer.removed"
+	TopicPRMerged           = "pr.merged"
+)
+
+// Consumers of the outbox, each of them gets its own copy of a message
+// and tracks its delivery independently
+const (
+	ConsumerWebhooks = "webhooks"
+	ConsumerCodeHost = "code_host"
+)
+
+var consumerTopics = map[string][]string{
+	ConsumerWebhooks: {TopicPRCreated, TopicReviewerAssigned, TopicReviewerReassigned, TopicReviewerRemoved, TopicPRMerged},
+	ConsumerCodeHost: {TopicReviewerAssigned, TopicReviewerReassigned, TopicReviewerRemoved},
+}
+
+var consumers = []string{ConsumerWebhooks, ConsumerCodeHost}
+
+// OutboxConsumers returns all known consumers of the outbox
+func OutboxConsumers() []string {
+	return slices.Clone(consumers)
+}
+
+// accepts reports whether consumer handles events of the pull request,
+// code host publishes only to pull requests opened on GitHub
+func accepts(consumer, prID string) bool {
+	if consumer != ConsumerCodeHost {
+		return true
+	}
+	_, ok := ParseGitHubPRID(prID)
+	return ok
+}
+
+var eventTopics = map[AssignmentEventType]string{
+	EventPRCreated:          TopicPRCreated,
+	EventReviewerAssigned:   TopicReviewerAssigned,
+	EventReviewerReassigned: TopicReviewerReassigned,
+	EventReviewerRemoved:    TopicReviewerRemoved,
+	EventPRMerged:           TopicPRMerged,
+}
+
+// OutboxMessage is a domain event waiting to be published
+type OutboxMessage struct {
+	ID       int64
+	Consumer string
+	Topic    string
+	Payload  json.RawMessage
+	Attempts int
+	// targets of the consumer that already accepted the message
+	DeliveredTargets []string
+}
+
+// PartialDeliveryError is returned by publisher when only some targets accepted the message,
+// they are not published to again when the message is retried
+type PartialDeliveryError struct {
+	Delivered []string
+	Err       error
+}
+
+func (e *PartialDeliveryError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PartialDeliveryError) Unwrap() error {
+	return e.Err
+}
+
+// DomainEvent is a payload of outbox message as it is seen by subscribers
+type DomainEvent struct {
+	Type          string `json:"type"`
+	PullRequestID string `json:"pull_request_id"`
+	UserID        string `json:"user_id,omitempty"`
+	OldUserID     string `json:"old_user_id,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+	ActorID       string `json:"actor_id,omitempty"`
+	OccurredAt    string `json:"occurred_at"`
+}
+
+// NewOutboxMessages returns a message for each of enabled consumers subscribed
+// to topic of the event, other events are skipped
+func NewOutboxMessages(events []AssignmentEvent, occurredAt time.Time, enabled []string) ([]OutboxMessage, error) {
+	var messages []OutboxMessage
+	for _, event := range events {
+		topic, ok := eventTopics[event.Type]
+		if !ok {
+			continue
+		}
+
+		payload, err := json.Marshal(DomainEvent{
+			Type:          topic,
+			PullRequestID: event.PullRequestID,
+			UserID:        event.UserID,
+			OldUserID:     event.OldUserID,
+			Reason:        event.Reason,
+			ActorID:       event.ActorID,
+			OccurredAt:    occurredAt.UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, consumer := range enabled {
+			if slices.Contains(consumerTopics[consumer], topic) && accepts(consumer, event.PullRequestID) {
+				messages = append(messages, OutboxMessage{Consumer: consumer, Topic: topic, Payload: payload})
+			}
+		}
+	}
+
+	return messages, nil
+}
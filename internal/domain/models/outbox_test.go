@@ -0,0 +1,88 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOutboxMessages(t *testing.T) {
+	occurredAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+
+	messages, err := NewOutboxMessages([]AssignmentEvent{
+		{Type: EventUserDeactivated, UserID: "u2"},
+		{Type: EventReviewerReassigned, PullRequestID: "acme/api#7", UserID: "u3", OldUserID: "u2", ActorID: "u1"},
+	}, occurredAt, OutboxConsumers())
+
+	require.NoError(t, err)
+	require.Len(t, messages, 2, "user events are not published")
+	assert.Equal(t, ConsumerWebhooks, messages[0].Consumer)
+	assert.Equal(t, ConsumerCodeHost, messages[1].Consumer)
+	assert.Equal(t, TopicReviewerReassigned, messages[0].Topic)
+	assert.Equal(t, messages[0].Payload, messages[1].Payload)
+	assert.JSONEq(t, `{
+		"type": "reviewer.reassigned",
+		"pull_request_id": "acme/api#7",
+		"user_id": "u3",
+		"old_user_id": "u2",
+		"actor_id": "u1",
+		"occurred_at": "2025-10-24T12:00:00Z"
+	}`, string(messages[0].Payload))
+}
+
+func TestNewOutboxMessages_ConsumerTopics(t *testing.T) {
+	messages, err := NewOutboxMessages([]AssignmentEvent{
+		{Type: EventPRCreated, PullRequestID: "acme/api#7", UserID: "u1"},
+		{Type: EventReviewerAssigned, PullRequestID: "acme/api#7", UserID: "u2"},
+		{Type: EventPRMerged, PullRequestID: "acme/api#7"},
+	}, time.Now(), OutboxConsumers())
+
+	require.NoError(t, err)
+	got := make([]string, len(messages))
+	for i, message := range messages {
+		got[i] = message.Consumer + " " + message.Topic
+	}
+	assert.Equal(t, []string{
+		"webhooks pr.created",
+		"webhooks reviewer.assigned",
+		"code_host reviewer.assigned",
+		"webhooks pr.merged",
+	}, got)
+}
+
+func TestNewOutboxMessages_CodeHostOnlyForGitHubPRs(t *testing.T) {
+	events := []AssignmentEvent{
+		{Type: EventReviewerAssigned, PullRequestID: "pr-1", UserID: "u2"},
+		{Type: EventReviewerAssigned, PullRequestID: GitLabPRID("acme/api", 3), UserID: "u2"},
+		{Type: EventReviewerAssigned, PullRequestID: "acme/api#7", UserID: "u2"},
+	}
+
+	t.Run("code host skips pull requests not opened on GitHub", func(t *testing.T) {
+		messages, err := NewOutboxMessages(events, time.Now(), OutboxConsumers())
+
+		require.NoError(t, err)
+		var codeHost []string
+		for _, message := range messages {
+			if message.Consumer == ConsumerCodeHost {
+				var payload DomainEvent
+				require.NoError(t, json.Unmarshal(message.Payload, &payload))
+				codeHost = append(codeHost, payload.PullRequestID)
+			}
+		}
+		assert.Len(t, messages, 4)
+		assert.Equal(t, []string{"acme/api#7"}, codeHost)
+	})
+
+	t.Run("disabled consumer gets no messages", func(t *testing.T) {
+		messages, err := NewOutboxMessages(events, time.Now(), []string{ConsumerWebhooks})
+
+		require.NoError(t, err)
+		require.Len(t, messages, 3)
+		for _, message := range messages {
+			assert.Equal(t, ConsumerWebhooks, message.Consumer)
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultPageLimit = 50
+	MaxPageLimit     = 500
+)
+
+// PRFilter narrows PR listing, zero fields are not applied.
+// PRs are ordered by created_at and id, newest first.
+type PRFilter struct {
+	Status      PRStatus
+	AuthorID    string
+	ReviewerID  string
+	TeamName    string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Cursor      string
+	Limit       int
+}
+
+// PRPage is a page of PR listing, NextCursor is empty on the last page
+type PRPage struct {
+	PullRequests []PullRequest `json:"pull_requests"`
+	NextCursor   string        `json:"next_cursor,omitempty"`
+}
+
+// PRCursor points to the last PR of a page
+type PRCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+func (f PRFilter) Validate() error {
+	switch f.Status {
+	case "", PRStatusDraft, PRStatusOpen, PRStatusMerged, PRStatusClosed:
+	default:
+		return ErrInvalidPRStatus
+	}
+
+	if f.Limit < 0 || f.Limit > MaxPageLimit {
+		return ErrInvalidPageLimit
+	}
+
+	if f.CreatedFrom != nil && f.CreatedTo != nil && f.CreatedFrom.After(*f.CreatedTo) {
+		return ErrInvalidTimeRange
+	}
+
+	if _, err := f.After(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PageLimit returns limit with default applied
+func (f PRFilter) PageLimit() int {
+	if f.Limit == 0 {
+		return DefaultPageLimit
+	}
+	return f.Limit
+}
+
+// After decodes cursor of the filter, nil means first page
+func (f PRFilter) After() (*PRCursor, error) {
+	if f.Cursor == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(f.Cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &PRCursor{CreatedAt: t, ID: id}, nil
+}
+
+func (c PRCursor) Encode() string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRFilter_Validate(t *testing.T) {
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		filter   PRFilter
+		expected error
+	}{
+		{
+			name:     "empty filter",
+			filter:   PRFilter{},
+			expected: nil,
+		},
+		{
+			name:     "all fields",
+			filter:   PRFilter{Status: PRStatusClosed, AuthorID: "u1", CreatedFrom: &from, CreatedTo: &to, Limit: 10},
+			expected: nil,
+		},
+		{
+			name:     "unknown status",
+			filter:   PRFilter{Status: "REVIEWED"},
+			expected: ErrInvalidPRStatus,
+		},
+		{
+			name:     "limit too big",
+			filter:   PRFilter{Limit: MaxPageLimit + 1},
+			expected: ErrInvalidPageLimit,
+		},
+		{
+			name:     "negative limit",
+			filter:   PRFilter{Limit: -1},
+			expected: ErrInvalidPageLimit,
+		},
+		{
+			name:     "inverted time range",
+			filter:   PRFilter{CreatedFrom: &to, CreatedTo: &from},
+			expected: ErrInvalidTimeRange,
+		},
+		{
+			name:     "malformed cursor",
+			filter:   PRFilter{Cursor: "not a cursor"},
+			expected: ErrInvalidCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.filter.Validate())
+		})
+	}
+}
+
+func TestPRCursor_RoundTrip(t *testing.T) {
+	cursor := PRCursor{
+		CreatedAt: time.Date(2025, 10, 24, 12, 34, 56, 789000, time.UTC),
+		ID:        "pr-1|with-pipe",
+	}
+
+	decoded, err := PRFilter{Cursor: cursor.Encode()}.After()
+	require.NoError(t, err)
+	require.NotNil(t, decoded)
+	assert.True(t, cursor.CreatedAt.Equal(decoded.CreatedAt))
+	assert.Equal(t, cursor.ID, decoded.ID)
+
+	first, err := PRFilter{}.After()
+	require.NoError(t, err)
+	assert.Nil(t, first)
+}
@@ -3,8 +3,19 @@ package models
 type PRStatus string
 
 const (
+	PRStatusDraft  PRStatus = "DRAFT"
 	PRStatusOpen   PRStatus = "OPEN"
 	PRStatusMerged PRStatus = "MERGED"
+	PRStatusClosed PRStatus = "CLOSED"
+)
+
+type ReviewDecision string
+
+const (
+	ReviewDecisionPending          ReviewDecision = "PENDING"
+	ReviewDecisionApproved         ReviewDecision = "APPROVED"
+	ReviewDecisionChangesRequested ReviewDecision = "CHANGES_REQUESTED"
+	ReviewDecisionCommented        ReviewDecision = "COMMENTED"
 )
 
 type PullRequest struct {
@@ -13,7 +24,43 @@ type PullRequest struct {
 	AuthorID          string   `json:"author_id"`
 	Status            PRStatus `json:"status"`
 	AssignedReviewers []string `json:"assigned_reviewers,omitempty"`
+	Reviews           []Review `json:"reviews,omitempty"`
+	CreatedAt         *string  `json:"createdAt,omitempty"`
 	MergedAt          *string  `json:"mergedAt,omitempty"`
+	ForceMerged       bool     `json:"force_merged,omitempty"`
+	// ChangedFiles are paths changed by PR, owners of them are preferred as reviewers
+	ChangedFiles []string `json:"changed_files,omitempty"`
+	// FallbackReviewers are assigned reviewers from partner teams of the author's team
+	FallbackReviewers []string `json:"fallback_reviewers,omitempty"`
+	// RequestedReviewers are chosen by the author, they are assigned first
+	// and replaced only on forced reassignment
+	RequestedReviewers []string `json:"requested_reviewers,omitempty"`
+}
+
+// Review is a decision of an assigned reviewer, PENDING until the reviewer submits one
+type Review struct {
+	ReviewerID string         `json:"reviewer_id"`
+	Decision   ReviewDecision `json:"decision"`
+	DecidedAt  *string        `json:"decided_at,omitempty"`
+	Fallback   bool           `json:"fallback,omitempty"`
+	Requested  bool           `json:"requested,omitempty"`
+}
+
+// Reviewer is an assigned reviewer with user details
+type Reviewer struct {
+	UserID     string `json:"user_id"`
+	Username   string `json:"username"`
+	TeamName   string `json:"team_name"`
+	IsActive   bool   `json:"is_active"`
+	AssignedAt string `json:"assigned_at"`
+	Fallback   bool   `json:"fallback,omitempty"`
+	Requested  bool   `json:"requested,omitempty"`
+}
+
+// PullRequestDetails is a PR with full objects of assigned reviewers
+type PullRequestDetails struct {
+	PullRequest
+	Reviewers []Reviewer `json:"reviewers"`
 }
 
 func (p PullRequest) Validate() error {
@@ -27,3 +74,34 @@ func (p PullRequest) Validate() error {
 	}
 	return nil
 }
+
+// Validate checks that decision can be submitted by a reviewer
+func (d ReviewDecision) Validate() error {
+	switch d {
+	case ReviewDecisionApproved, ReviewDecisionChangesRequested, ReviewDecisionCommented:
+		return nil
+	}
+	return ErrInvalidReviewDecision
+}
+
+// CheckMergeRules checks that PR has enough approvals
+// and no outstanding change requests if the team requires so
+func (p PullRequest) CheckMergeRules(settings TeamSettings) error {
+	var approvals int
+	for _, review := range p.Reviews {
+		switch review.Decision {
+		case ReviewDecisionApproved:
+			approvals++
+		case ReviewDecisionChangesRequested:
+			if settings.BlockOnChangesRequested {
+				return ErrMergeRequirementsNotMet
+			}
+		}
+	}
+
+	if approvals < settings.RequiredApprovals {
+		return ErrMergeRequirementsNotMet
+	}
+
+	return nil
+}
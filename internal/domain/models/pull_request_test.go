@@ -67,3 +67,74 @@ func TestPullRequest_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestReviewDecision_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		decision ReviewDecision
+		expected error
+	}{
+		{name: "approved", decision: ReviewDecisionApproved, expected: nil},
+		{name: "changes requested", decision: ReviewDecisionChangesRequested, expected: nil},
+		{name: "commented", decision: ReviewDecisionCommented, expected: nil},
+		{name: "pending cannot be submitted", decision: ReviewDecisionPending, expected: ErrInvalidReviewDecision},
+		{name: "empty", decision: "", expected: ErrInvalidReviewDecision},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.decision.Validate())
+		})
+	}
+}
+
+func TestPullRequest_CheckMergeRules(t *testing.T) {
+	approved := Review{ReviewerID: "u1", Decision: ReviewDecisionApproved}
+	changes := Review{ReviewerID: "u2", Decision: ReviewDecisionChangesRequested}
+	pending := Review{ReviewerID: "u3", Decision: ReviewDecisionPending}
+
+	tests := []struct {
+		name     string
+		reviews  []Review
+		settings TeamSettings
+		expected error
+	}{
+		{
+			name:     "no rules",
+			reviews:  []Review{pending, changes},
+			settings: DefaultTeamSettings(),
+			expected: nil,
+		},
+		{
+			name:     "enough approvals",
+			reviews:  []Review{approved, pending},
+			settings: TeamSettings{RequiredApprovals: 1},
+			expected: nil,
+		},
+		{
+			name:     "not enough approvals",
+			reviews:  []Review{approved, pending},
+			settings: TeamSettings{RequiredApprovals: 2},
+			expected: ErrMergeRequirementsNotMet,
+		},
+		{
+			name:     "changes requested block merge",
+			reviews:  []Review{approved, changes},
+			settings: TeamSettings{RequiredApprovals: 1, BlockOnChangesRequested: true},
+			expected: ErrMergeRequirementsNotMet,
+		},
+		{
+			name:     "changes requested ignored when not blocking",
+			reviews:  []Review{approved, changes},
+			settings: TeamSettings{RequiredApprovals: 1},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := PullRequest{ID: "pr-1", Reviews: tt.reviews}
+			assert.Equal(t, tt.expected, pr.CheckMergeRules(tt.settings))
+		})
+	}
+}
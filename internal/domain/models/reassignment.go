@@ -0,0 +1,25 @@
+package models
+
+// ReviewReassignment describes replacement of a reviewer on a single PR.
+// NewReviewerID is empty if no replacement was found.
+type ReviewReassignment struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"replaced_by,omitempty"`
+}
+
+// ReassignOptions are optional details of a manual reassignment
+type ReassignOptions struct {
+	ActorID string
+	Reason  string
+	// Force allows to replace a reviewer requested by the author
+	Force bool
+	// NewReviewerID is the chosen replacement, it is picked by the team strategy when empty
+	NewReviewerID string
+}
+
+// ReassignmentReport is an outcome of moving open reviews away from deactivated users
+type ReassignmentReport struct {
+	Reassigned    []ReviewReassignment `json:"reassigned"`
+	NotReassigned []ReviewReassignment `json:"not_reassigned"`
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	DefaultStatsWeeks = 12
+	MaxStatsWeeks     = 104
+)
+
+// StatsFilter limits statistics to a team and to assignments made in [From, To),
+// zero fields are not applied
+type StatsFilter struct {
+	TeamName string
+	From     *time.Time
+	To       *time.Time
+}
+
+func (f StatsFilter) Validate() error {
+	if f.From != nil && f.To != nil && f.From.After(*f.To) {
+		return ErrInvalidTimeRange
+	}
+	return nil
+}
+
+// ReviewerStats aggregates review assignments of a single user.
+// Assignments reassigned away from the user are counted in TotalAssignments.
+type ReviewerStats struct {
+	UserID           string `json:"user_id"`
+	Username         string `json:"username"`
+	TeamName         string `json:"team_name"`
+	TotalAssignments int    `json:"total_assignments"`
+	OpenAssignments  int    `json:"open_assignments"`
+	ReassignedAway   int    `json:"reassigned_away"`
+	// AvgTimeToMergeSeconds is nil if none of the assigned PRs is merged
+	AvgTimeToMergeSeconds *float64 `json:"avg_time_to_merge_seconds"`
+}
+
+// TeamStats describes PR flow of a team and fairness of review assignment.
+// PRs belong to the team of their author.
+type TeamStats struct {
+	TeamName      string        `json:"team_name"`
+	OpenPRs       int           `json:"open_prs"`
+	MergedPerWeek []WeeklyCount `json:"merged_per_week"`
+	// MedianTimeToMergeSeconds is measured from PR creation, nil if nothing is merged
+	MedianTimeToMergeSeconds *float64         `json:"median_time_to_merge_seconds"`
+	ReviewLoad               ReviewLoadSpread `json:"review_load"`
+}
+
+// WeeklyCount is a count for the week starting on Monday WeekStart
+type WeeklyCount struct {
+	WeekStart string `json:"week_start"`
+	Count     int    `json:"count"`
+}
+
+// ReviewLoadSpread describes distribution of open assignments across active team members
+type ReviewLoadSpread struct {
+	Members int     `json:"members"`
+	Min     int     `json:"min"`
+	Max     int     `json:"max"`
+	StdDev  float64 `json:"stddev"`
+}
+
+// NewReviewLoadSpread computes spread of the loads, StdDev is a population one
+func NewReviewLoadSpread(loads []int) ReviewLoadSpread {
+	if len(loads) == 0 {
+		return ReviewLoadSpread{}
+	}
+
+	spread := ReviewLoadSpread{Members: len(loads), Min: loads[0], Max: loads[0]}
+
+	var sum float64
+	for _, load := range loads {
+		spread.Min = min(spread.Min, load)
+		spread.Max = max(spread.Max, load)
+		sum += float64(load)
+	}
+
+	mean := sum / float64(len(loads))
+	var variance float64
+	for _, load := range loads {
+		variance += (float64(load) - mean) * (float64(load) - mean)
+	}
+	spread.StdDev = math.Sqrt(variance / float64(len(loads)))
+
+	return spread
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReviewLoadSpread(t *testing.T) {
+	tests := []struct {
+		name     string
+		loads    []int
+		expected ReviewLoadSpread
+	}{
+		{
+			name:     "no members",
+			loads:    nil,
+			expected: ReviewLoadSpread{},
+		},
+		{
+			name:     "even load",
+			loads:    []int{2, 2, 2},
+			expected: ReviewLoadSpread{Members: 3, Min: 2, Max: 2, StdDev: 0},
+		},
+		{
+			name:     "uneven load",
+			loads:    []int{2, 4, 4, 4, 5, 5, 7, 9},
+			expected: ReviewLoadSpread{Members: 8, Min: 2, Max: 9, StdDev: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NewReviewLoadSpread(tt.loads))
+		})
+	}
+}
@@ -1,8 +1,86 @@
 package models
 
+type ReviewStrategy string
+
+const (
+	ReviewStrategyRandom      ReviewStrategy = "RANDOM"
+	ReviewStrategyRoundRobin  ReviewStrategy = "ROUND_ROBIN"
+	ReviewStrategyLeastLoaded ReviewStrategy = "LEAST_LOADED"
+	ReviewStrategyWeighted    ReviewStrategy = "WEIGHTED"
+)
+
+// OverflowPolicy decides what happens when candidate reviewers are at capacity
+type OverflowPolicy string
+
+const (
+	// reviewers at capacity are assigned as if they had no limit
+	OverflowAssignAnyway OverflowPolicy = "ASSIGN_ANYWAY"
+	// PR gets fewer reviewers than the team needs
+	OverflowAssignFewer OverflowPolicy = "ASSIGN_FEWER"
+	// PR is not created and reviewer is not replaced
+	OverflowReject OverflowPolicy = "REJECT"
+)
+
+const (
+	DefaultReviewersCount = 2
+	MaxReviewersCount     = 10
+)
+
 type Team struct {
-	Name    string `json:"team_name"`
-	Members []User `json:"members"`
+	Name     string       `json:"team_name"`
+	Members  []User       `json:"members"`
+	Settings TeamSettings `json:"settings"`
+	// PartnerTeams fill the remaining reviewer slots when the team is short of active members
+	PartnerTeams []string `json:"partner_teams,omitempty"`
+}
+
+// TeamSettings describes how reviewers are picked for PRs of the team
+// and what is required to merge them
+type TeamSettings struct {
+	ReviewStrategy          ReviewStrategy `json:"review_strategy"`
+	ReviewersCount          int            `json:"reviewers_count"`
+	RequiredApprovals       int            `json:"required_approvals"`
+	BlockOnChangesRequested bool           `json:"block_on_changes_requested"`
+	// OverflowPolicy is applied when there are not enough reviewers below their capacity,
+	// empty policy is ASSIGN_ANYWAY
+	OverflowPolicy OverflowPolicy `json:"overflow_policy"`
+}
+
+func DefaultTeamSettings() TeamSettings {
+	return TeamSettings{
+		ReviewStrategy: ReviewStrategyLeastLoaded,
+		ReviewersCount: DefaultReviewersCount,
+		OverflowPolicy: OverflowAssignAnyway,
+	}
+}
+
+// TeamSettingsPatch changes only the settings that are set
+type TeamSettingsPatch struct {
+	ReviewStrategy          *ReviewStrategy
+	ReviewersCount          *int
+	RequiredApprovals       *int
+	BlockOnChangesRequested *bool
+	OverflowPolicy          *OverflowPolicy
+}
+
+// Apply returns settings with the set fields of the patch
+func (p TeamSettingsPatch) Apply(settings TeamSettings) TeamSettings {
+	if p.ReviewStrategy != nil {
+		settings.ReviewStrategy = *p.ReviewStrategy
+	}
+	if p.ReviewersCount != nil {
+		settings.ReviewersCount = *p.ReviewersCount
+	}
+	if p.RequiredApprovals != nil {
+		settings.RequiredApprovals = *p.RequiredApprovals
+	}
+	if p.BlockOnChangesRequested != nil {
+		settings.BlockOnChangesRequested = *p.BlockOnChangesRequested
+	}
+	if p.OverflowPolicy != nil {
+		settings.OverflowPolicy = *p.OverflowPolicy
+	}
+	return settings
 }
 
 func (t Team) Validate() error {
@@ -20,3 +98,42 @@ func (t Team) Validate() error {
 
 	return nil
 }
+
+// WithDefaults fills each of unset settings from DefaultTeamSettings
+func (s TeamSettings) WithDefaults() TeamSettings {
+	defaults := DefaultTeamSettings()
+	if s.ReviewStrategy == "" {
+		s.ReviewStrategy = defaults.ReviewStrategy
+	}
+	if s.ReviewersCount == 0 {
+		s.ReviewersCount = defaults.ReviewersCount
+	}
+	if s.OverflowPolicy == "" {
+		s.OverflowPolicy = defaults.OverflowPolicy
+	}
+	return s
+}
+
+func (s TeamSettings) Validate() error {
+	switch s.ReviewStrategy {
+	case ReviewStrategyRandom, ReviewStrategyRoundRobin, ReviewStrategyLeastLoaded, ReviewStrategyWeighted:
+	default:
+		return ErrInvalidReviewStrategy
+	}
+
+	if s.ReviewersCount < 1 || s.ReviewersCount > MaxReviewersCount {
+		return ErrInvalidReviewersCount
+	}
+
+	if s.RequiredApprovals < 0 || s.RequiredApprovals > s.ReviewersCount {
+		return ErrInvalidRequiredApprovals
+	}
+
+	switch s.OverflowPolicy {
+	case "", OverflowAssignAnyway, OverflowAssignFewer, OverflowReject:
+	default:
+		return ErrInvalidOverflowPolicy
+	}
+
+	return nil
+}
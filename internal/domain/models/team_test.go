@@ -74,3 +74,147 @@ func TestTeam_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestTeamSettings_WithDefaults(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings TeamSettings
+		expected TeamSettings
+	}{
+		{
+			name:     "zero settings",
+			settings: TeamSettings{},
+			expected: DefaultTeamSettings(),
+		},
+		{
+			name:     "only required approvals",
+			settings: TeamSettings{RequiredApprovals: 1},
+			expected: TeamSettings{
+				ReviewStrategy:    ReviewStrategyLeastLoaded,
+				ReviewersCount:    DefaultReviewersCount,
+				RequiredApprovals: 1,
+				OverflowPolicy:    OverflowAssignAnyway,
+			},
+		},
+		{
+			name:     "set settings are kept",
+			settings: TeamSettings{ReviewStrategy: ReviewStrategyRandom, ReviewersCount: 3, OverflowPolicy: OverflowReject},
+			expected: TeamSettings{ReviewStrategy: ReviewStrategyRandom, ReviewersCount: 3, OverflowPolicy: OverflowReject},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.settings.WithDefaults())
+		})
+	}
+}
+
+func TestTeamSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings TeamSettings
+		expected error
+	}{
+		{
+			name:     "default settings",
+			settings: DefaultTeamSettings(),
+			expected: nil,
+		},
+		{
+			name: "valid round robin",
+			settings: TeamSettings{
+				ReviewStrategy: ReviewStrategyRoundRobin,
+				ReviewersCount: 3,
+			},
+			expected: nil,
+		},
+		{
+			name: "unknown strategy",
+			settings: TeamSettings{
+				ReviewStrategy: "ALPHABETICAL",
+				ReviewersCount: 2,
+			},
+			expected: ErrInvalidReviewStrategy,
+		},
+		{
+			name: "zero reviewers",
+			settings: TeamSettings{
+				ReviewStrategy: ReviewStrategyRandom,
+				ReviewersCount: 0,
+			},
+			expected: ErrInvalidReviewersCount,
+		},
+		{
+			name: "required approvals above reviewers count",
+			settings: TeamSettings{
+				ReviewStrategy:    ReviewStrategyRandom,
+				ReviewersCount:    2,
+				RequiredApprovals: 3,
+			},
+			expected: ErrInvalidRequiredApprovals,
+		},
+		{
+			name: "too many reviewers",
+			settings: TeamSettings{
+				ReviewStrategy: ReviewStrategyWeighted,
+				ReviewersCount: MaxReviewersCount + 1,
+			},
+			expected: ErrInvalidReviewersCount,
+		},
+		{
+			name: "reject on overflow",
+			settings: TeamSettings{
+				ReviewStrategy: ReviewStrategyLeastLoaded,
+				ReviewersCount: 2,
+				OverflowPolicy: OverflowReject,
+			},
+			expected: nil,
+		},
+		{
+			name: "unknown overflow policy",
+			settings: TeamSettings{
+				ReviewStrategy: ReviewStrategyLeastLoaded,
+				ReviewersCount: 2,
+				OverflowPolicy: "QUEUE",
+			},
+			expected: ErrInvalidOverflowPolicy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+			assert.Equal(t, tt.expected, err)
+		})
+	}
+}
+
+func TestTeamSettingsPatch_Apply(t *testing.T) {
+	stored := TeamSettings{
+		ReviewStrategy:          ReviewStrategyRandom,
+		ReviewersCount:          2,
+		RequiredApprovals:       1,
+		BlockOnChangesRequested: true,
+		OverflowPolicy:          OverflowAssignFewer,
+	}
+
+	t.Run("omitted overflow policy is kept", func(t *testing.T) {
+		count := 3
+
+		result := TeamSettingsPatch{ReviewersCount: &count}.Apply(stored)
+
+		assert.Equal(t, OverflowAssignFewer, result.OverflowPolicy)
+		assert.Equal(t, 3, result.ReviewersCount)
+	})
+
+	t.Run("overflow policy is changed when set", func(t *testing.T) {
+		policy := OverflowReject
+
+		result := TeamSettingsPatch{OverflowPolicy: &policy}.Apply(stored)
+
+		expected := stored
+		expected.OverflowPolicy = OverflowReject
+		assert.Equal(t, expected, result)
+	})
+}
@@ -1,10 +1,65 @@
 package models
 
+import "time"
+
 type User struct {
 	ID       string `json:"user_id"`
 	Username string `json:"username"`
 	IsActive bool   `json:"is_active"`
 	TeamName string `json:"team_name,omitempty"`
+	// MaxOpenReviews limits open reviews of the user, nil means no limit
+	MaxOpenReviews *int `json:"max_open_reviews,omitempty"`
+	// Timezone is an IANA name of the user's timezone, empty means UTC
+	Timezone string `json:"timezone,omitempty"`
+	// WorkingHours are nil if the user can be picked as a reviewer at any time
+	WorkingHours *WorkingHours `json:"working_hours,omitempty"`
+	// OpenReviews is filled only for candidate reviewers
+	OpenReviews int `json:"-"`
+}
+
+// AtCapacity reports whether the user cannot take one more review
+func (u User) AtCapacity() bool {
+	return u.MaxOpenReviews != nil && u.OpenReviews >= *u.MaxOpenReviews
+}
+
+// UntilWorkingHours returns how long it is until the user starts working,
+// it is zero when the user is working at now or has no working hours.
+// Start of work is found on the user's calendar, so the wait is correct across DST changes.
+func (u User) UntilWorkingHours(now time.Time) (time.Duration, error) {
+	if u.WorkingHours == nil {
+		return 0, nil
+	}
+
+	loc, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		return 0, ErrInvalidTimezone
+	}
+	start, err := ParseClock(u.WorkingHours.Start)
+	if err != nil {
+		return 0, ErrInvalidWorkingHours
+	}
+	end, err := ParseClock(u.WorkingHours.End)
+	if err != nil {
+		return 0, ErrInvalidWorkingHours
+	}
+
+	local := now.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+
+	working := start <= minute && minute < end
+	if end < start {
+		working = minute >= start || minute < end
+	}
+	if working {
+		return 0, nil
+	}
+
+	year, month, day := local.Date()
+	startsAt := time.Date(year, month, day, start/60, start%60, 0, 0, loc)
+	if !startsAt.After(now) {
+		startsAt = time.Date(year, month, day+1, start/60, start%60, 0, 0, loc)
+	}
+	return startsAt.Sub(now), nil
 }
 
 func (u User) Equals(other User) bool {
@@ -3,7 +3,10 @@ package models
 
 import (
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
+	_ "time/tzdata"
 )
 
 func TestUser_Equals(t *testing.T) {
@@ -95,3 +98,68 @@ func TestUser_Equals(t *testing.T) {
 		})
 	}
 }
+
+func TestUser_AtCapacity(t *testing.T) {
+	limit := 2
+
+	assert.False(t, User{OpenReviews: 5}.AtCapacity())
+	assert.False(t, User{MaxOpenReviews: &limit, OpenReviews: 1}.AtCapacity())
+	assert.True(t, User{MaxOpenReviews: &limit, OpenReviews: 2}.AtCapacity())
+	assert.True(t, User{MaxOpenReviews: &limit, OpenReviews: 3}.AtCapacity())
+}
+
+func TestUser_UntilWorkingHours(t *testing.T) {
+	// 12:00 UTC is 15:00 in Moscow, 08:00 in New York and 21:00 in Tokyo
+	now := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+	dayShift := &WorkingHours{Start: "09:00", End: "18:00"}
+
+	tests := []struct {
+		name     string
+		user     User
+		expected time.Duration
+	}{
+		{name: "no working hours", user: User{Timezone: "America/New_York"}, expected: 0},
+		{name: "working now", user: User{Timezone: "Europe/Moscow", WorkingHours: dayShift}, expected: 0},
+		{name: "starts later today", user: User{Timezone: "America/New_York", WorkingHours: dayShift}, expected: time.Hour},
+		{name: "starts tomorrow", user: User{Timezone: "Asia/Tokyo", WorkingHours: dayShift}, expected: 12 * time.Hour},
+		{name: "utc by default", user: User{WorkingHours: dayShift}, expected: 0},
+		{name: "night shift", user: User{Timezone: "Asia/Tokyo", WorkingHours: &WorkingHours{Start: "20:00", End: "02:00"}}, expected: 0},
+		{name: "night shift before start", user: User{WorkingHours: &WorkingHours{Start: "22:00", End: "02:00"}}, expected: 10 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wait, err := tt.user.UntilWorkingHours(now)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, wait)
+		})
+	}
+
+	t.Run("dst change", func(t *testing.T) {
+		// clocks in Berlin go forward at 02:00 on 2025-03-30, 20:00 local is 19:00 UTC
+		evening := time.Date(2025, 3, 29, 19, 0, 0, 0, time.UTC)
+		user := User{Timezone: "Europe/Berlin", WorkingHours: dayShift}
+
+		wait, err := user.UntilWorkingHours(evening)
+
+		require.NoError(t, err)
+		assert.Equal(t, 12*time.Hour, wait)
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		user := User{Timezone: "Mars/Olympus", WorkingHours: dayShift}
+
+		_, err := user.UntilWorkingHours(now)
+
+		assert.ErrorIs(t, err, ErrInvalidTimezone)
+	})
+
+	t.Run("invalid working hours", func(t *testing.T) {
+		user := User{WorkingHours: &WorkingHours{Start: "9am", End: "18:00"}}
+
+		_, err := user.UntilWorkingHours(now)
+
+		assert.ErrorIs(t, err, ErrInvalidWorkingHours)
+	})
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// WorkingHours is a daily working time in the user's timezone as "HH:MM",
+// End before Start means that work ends on the next day
+type WorkingHours struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+func (h WorkingHours) Validate() error {
+	start, err := ParseClock(h.Start)
+	if err != nil {
+		return ErrInvalidWorkingHours
+	}
+	end, err := ParseClock(h.End)
+	if err != nil || start == end {
+		return ErrInvalidWorkingHours
+	}
+	return nil
+}
+
+// ParseClock converts "HH:MM" to minutes since midnight
+func ParseClock(clock string) (int, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// FormatClock converts minutes since midnight to "HH:MM"
+func FormatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// ValidateTimezone checks that tz is an IANA timezone name, empty tz is UTC
+func ValidateTimezone(tz string) error {
+	if _, err := time.LoadLocation(tz); err != nil {
+		return ErrInvalidTimezone
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkingHours_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		hours   WorkingHours
+		wantErr error
+	}{
+		{name: "day shift", hours: WorkingHours{Start: "09:00", End: "18:00"}},
+		{name: "night shift", hours: WorkingHours{Start: "22:00", End: "06:30"}},
+		{name: "empty", hours: WorkingHours{}, wantErr: ErrInvalidWorkingHours},
+		{name: "bad format", hours: WorkingHours{Start: "9am", End: "18:00"}, wantErr: ErrInvalidWorkingHours},
+		{name: "out of range", hours: WorkingHours{Start: "09:00", End: "24:00"}, wantErr: ErrInvalidWorkingHours},
+		{name: "empty range", hours: WorkingHours{Start: "09:00", End: "09:00"}, wantErr: ErrInvalidWorkingHours},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.hours.Validate())
+		})
+	}
+}
+
+func TestValidateTimezone(t *testing.T) {
+	assert.NoError(t, ValidateTimezone("Europe/Moscow"))
+	assert.NoError(t, ValidateTimezone(""))
+	assert.Equal(t, ErrInvalidTimezone, ValidateTimezone("Mars/Olympus"))
+}
+
+func TestClock(t *testing.T) {
+	minutes, err := ParseClock("09:30")
+	assert.NoError(t, err)
+	assert.Equal(t, 570, minutes)
+	assert.Equal(t, "09:30", FormatClock(570))
+	assert.Equal(t, "00:00", FormatClock(0))
+}
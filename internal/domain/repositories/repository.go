@@ -2,23 +2,43 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/437d5/pr-review-manager/internal/domain/models"
 )
 
 type PullRequestRepository interface {
 	Create(context.Context, models.PullRequest) (models.PullRequest, error)
-	Merge(context.Context, string) (models.PullRequest, error)
+	Merge(context.Context, string, bool) (models.PullRequest, error)
+	SetStatus(context.Context, string, models.PRStatus) (models.PullRequest, error)
+	AssignReviewers(context.Context, string, []string) error
+	MarkFallbackReviewers(context.Context, string, []string) error
+	AssignRequestedReviewers(context.Context, string, []string) error
 	Reassign(context.Context, string, string, string) (models.PullRequest, error)
-	GetPRs(context.Context, string) ([]models.PullRequest, error)
+	AddReviewer(context.Context, string, string) (models.PullRequest, error)
+	RemoveReviewer(context.Context, string, string) (models.PullRequest, error)
+	ReassignBulk(context.Context, []models.ReviewReassignment) error
+	GetPRs(context.Context, string, models.PRFilter) (models.PRPage, error)
+	List(context.Context, models.PRFilter) (models.PRPage, error)
+	CountPRs(context.Context, models.PRFilter) (int, error)
+	GetOpenByReviewers(context.Context, []string) ([]models.PullRequest, error)
 	GetByID(context.Context, string) (models.PullRequest, error)
 	GetReviewers(context.Context, string) ([]models.User, error)
+	GetReviewerDetails(context.Context, string) ([]models.Reviewer, error)
+	SubmitReview(context.Context, string, string, models.ReviewDecision) (models.PullRequest, error)
+	CountOpenReviews(context.Context, []string) (map[string]int, error)
+	GetLastAssignedAt(context.Context, []string) (map[string]time.Time, error)
 }
 
 type TeamRepository interface {
 	Create(context.Context, models.Team) (int, error)
 	GetByName(context.Context, string) (models.Team, error)
 	Exists(context.Context, string) (bool, error)
+	GetSettings(context.Context, string) (models.TeamSettings, error)
+	UpdateSettings(context.Context, string, models.TeamSettings) (models.TeamSettings, error)
+	GetOwnershipRules(context.Context, string) ([]models.OwnershipRule, error)
+	ReplaceOwnershipRules(context.Context, string, []models.OwnershipRule) error
+	ReplacePartners(context.Context, string, []string) error
 }
 
 type UserRepository interface {
@@ -26,13 +46,45 @@ type UserRepository interface {
 	GetByID(context.Context, string) (models.User, error)
 	Update(context.Context, models.User, int) (models.User, error)
 	SetIsActive(context.Context, string, bool) (models.User, error)
+	SetTeamIsActive(context.Context, string, []string, bool) ([]models.User, error)
+	SetMaxOpenReviews(context.Context, string, *int) (models.User, error)
+	SetWorkingHours(context.Context, string, string, *models.WorkingHours) (models.User, error)
 	GetActiveTeammatesByUserID(context.Context, string) ([]models.User, error)
+	GetActivePartnersByUserID(context.Context, string) ([]models.User, error)
+	GetActiveMembersByTeamName(context.Context, string) ([]models.User, error)
+	ReplaceAbsences(context.Context, string, []models.Absence) ([]models.Absence, error)
+	GetStartedAbsences(context.Context, int) ([]models.Absence, error)
+	MarkAbsenceReassigned(context.Context, int64) error
+}
+
+type StatsRepository interface {
+	GetReviewerStats(context.Context, models.StatsFilter) ([]models.ReviewerStats, error)
+	GetTeamPRStats(context.Context, string) (models.TeamStats, error)
+	GetMergedPerWeek(context.Context, string, int) ([]models.WeeklyCount, error)
+}
+
+type EventRepository interface {
+	Append(context.Context, []models.AssignmentEvent) error
+	GetByPR(context.Context, string) ([]models.AssignmentEvent, error)
+}
+
+type OutboxRepository interface {
+	Add(context.Context, []models.OutboxMessage) error
+	Claim(context.Context, string, int, time.Duration) ([]models.OutboxMessage, error)
+	MarkDelivered(context.Context, int64) error
+	MarkFailed(context.Context, int64, string, time.Duration) error
+	Postpone(context.Context, int64, string, time.Duration) error
+	AddDeliveredTargets(context.Context, int64, []string) error
+	MarkDead(context.Context, int64, string) error
 }
 
 type UnitOfWork interface {
 	Teams() TeamRepository
 	Users() UserRepository
 	PR() PullRequestRepository
+	Stats() StatsRepository
+	Events() EventRepository
+	Outbox() OutboxRepository
 
 	// Work with transactions
 	Begin(context.Context) error
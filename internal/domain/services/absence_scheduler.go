@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// AbsentReviewsReassigner moves open reviews away from users whose absence has started
+type AbsentReviewsReassigner interface {
+	ReassignAbsentReviews(ctx context.Context, limit int) (int, error)
+}
+
+type AbsenceSchedulerConfig struct {
+	PollInterval time.Duration
+	// absences handled in a single call, each of them in its own transaction
+	BatchSize int
+}
+
+func DefaultAbsenceSchedulerConfig() AbsenceSchedulerConfig {
+	return AbsenceSchedulerConfig{
+		PollInterval: time.Minute,
+		BatchSize:    20,
+	}
+}
+
+// AbsenceScheduler periodically reassigns open reviews of users who went out of office,
+// an absence is handled once, on the first poll after it started
+type AbsenceScheduler struct {
+	reassigner AbsentReviewsReassigner
+	cfg        AbsenceSchedulerConfig
+}
+
+// NewAbsenceScheduler replaces non-positive values of cfg with the defaults
+func NewAbsenceScheduler(reassigner AbsentReviewsReassigner, cfg AbsenceSchedulerConfig) *AbsenceScheduler {
+	defaults := DefaultAbsenceSchedulerConfig()
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaults.PollInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaults.BatchSize
+	}
+
+	return &AbsenceScheduler{
+		reassigner: reassigner,
+		cfg:        cfg,
+	}
+}
+
+// Run handles started absences until ctx is done
+func (s *AbsenceScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.Tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick handles all absences that have started so far and returns their number
+func (s *AbsenceScheduler) Tick(ctx context.Context) int {
+	total := 0
+	for {
+		n, err := s.reassigner.ReassignAbsentReviews(ctx, s.cfg.BatchSize)
+		if err != nil {
+			slog.Error("cannot reassign reviews of absent users", "error", err.Error())
+			return total
+		}
+		total += n
+		if n < s.cfg.BatchSize {
+			return total
+		}
+	}
+}
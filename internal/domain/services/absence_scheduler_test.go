@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type reassignerFunc func(ctx context.Context, limit int) (int, error)
+
+func (f reassignerFunc) ReassignAbsentReviews(ctx context.Context, limit int) (int, error) {
+	return f(ctx, limit)
+}
+
+func TestAbsenceScheduler_Tick(t *testing.T) {
+	tests := []struct {
+		name      string
+		batches   []int
+		failAt    int
+		wantCalls int
+		wantTotal int
+	}{
+		{name: "nothing started", batches: []int{0}, failAt: -1, wantCalls: 1, wantTotal: 0},
+		{name: "partial batch", batches: []int{2}, failAt: -1, wantCalls: 1, wantTotal: 2},
+		{name: "backlog is drained", batches: []int{3, 3, 1}, failAt: -1, wantCalls: 3, wantTotal: 7},
+		{name: "stops on error", batches: []int{3, 3}, failAt: 1, wantCalls: 2, wantTotal: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			scheduler := NewAbsenceScheduler(reassignerFunc(func(ctx context.Context, limit int) (int, error) {
+				assert.Equal(t, 3, limit)
+				defer func() { calls++ }()
+				if calls == tt.failAt {
+					return 0, errors.New("db is down")
+				}
+				return tt.batches[calls], nil
+			}), AbsenceSchedulerConfig{BatchSize: 3})
+
+			assert.Equal(t, tt.wantTotal, scheduler.Tick(context.Background()))
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
+
+func TestNewAbsenceScheduler_Defaults(t *testing.T) {
+	scheduler := NewAbsenceScheduler(nil, AbsenceSchedulerConfig{PollInterval: -time.Second})
+
+	assert.Equal(t, DefaultAbsenceSchedulerConfig(), scheduler.cfg)
+}
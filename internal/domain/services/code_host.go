@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+)
+
+// CodeHostClient changes requested reviewers of a PR on the code host
+type CodeHostClient interface {
+	RequestReviewers(ctx context.Context, pr models.CodeHostPR, reviewers []string) error
+	RemoveReviewers(ctx context.Context, pr models.CodeHostPR, reviewers []string) error
+}
+
+// CodeHostPublisher mirrors reviewer assignments from the outbox to the code host,
+// PRs that were not imported from it are skipped
+type CodeHostPublisher struct {
+	client CodeHostClient
+}
+
+func NewCodeHostPublisher(client CodeHostClient) *CodeHostPublisher {
+	return &CodeHostPublisher{client: client}
+}
+
+func (p *CodeHostPublisher) Publish(ctx context.Context, message models.OutboxMessage) error {
+	var event models.DomainEvent
+	if err := json.Unmarshal(message.Payload, &event); err != nil {
+		return fmt.Errorf("%w: cannot decode event: %v", models.ErrPermanentDelivery, err)
+	}
+
+	pr, ok := models.ParseGitHubPRID(event.PullRequestID)
+	if !ok {
+		slog.Debug("pr is not on code host, skipping", "pr_id", event.PullRequestID)
+		return nil
+	}
+
+	switch message.Topic {
+	case models.TopicReviewerAssigned:
+		return p.client.RequestReviewers(ctx, pr, []string{event.UserID})
+	case models.TopicReviewerReassigned:
+		// request the new reviewer first so PR is never left without one
+		if err := p.client.RequestReviewers(ctx, pr, []string{event.UserID}); err != nil {
+			return err
+		}
+		return p.client.RemoveReviewers(ctx, pr, []string{event.OldUserID})
+	case models.TopicReviewerRemoved:
+		return p.client.RemoveReviewers(ctx, pr, []string{event.UserID})
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type codeHostCall struct {
+	method    string
+	pr        models.CodeHostPR
+	reviewers []string
+}
+
+type fakeCodeHostClient struct {
+	calls []codeHostCall
+	err   error
+}
+
+func (c *fakeCodeHostClient) RequestReviewers(ctx context.Context, pr models.CodeHostPR, reviewers []string) error {
+	c.calls = append(c.calls, codeHostCall{"request", pr, reviewers})
+	return c.err
+}
+
+func (c *fakeCodeHostClient) RemoveReviewers(ctx context.Context, pr models.CodeHostPR, reviewers []string) error {
+	c.calls = append(c.calls, codeHostCall{"remove", pr, reviewers})
+	return c.err
+}
+
+func TestCodeHostPublisher_Publish(t *testing.T) {
+	ctx := context.Background()
+	pr := models.CodeHostPR{Repository: "octo/app", Number: 42}
+
+	tests := []struct {
+		name    string
+		message models.OutboxMessage
+		want    []codeHostCall
+	}{
+		{
+			name: "assigned",
+			message: models.OutboxMessage{
+				Topic:   models.TopicReviewerAssigned,
+				Payload: []byte(`{"type":"REVIEWER_ASSIGNED","pull_request_id":"octo/app#42","user_id":"alice"}`),
+			},
+			want: []codeHostCall{{"request", pr, []string{"alice"}}},
+		},
+		{
+			name: "reassigned",
+			message: models.OutboxMessage{
+				Topic:   models.TopicReviewerReassigned,
+				Payload: []byte(`{"type":"REVIEWER_REASSIGNED","pull_request_id":"octo/app#42","user_id":"bob","old_user_id":"alice"}`),
+			},
+			want: []codeHostCall{
+				{"request", pr, []string{"bob"}},
+				{"remove", pr, []string{"alice"}},
+			},
+		},
+		{
+			name: "removed",
+			message: models.OutboxMessage{
+				Topic:   models.TopicReviewerRemoved,
+				Payload: []byte(`{"type":"REVIEWER_REMOVED","pull_request_id":"octo/app#42","user_id":"alice"}`),
+			},
+			want: []codeHostCall{{"remove", pr, []string{"alice"}}},
+		},
+		{
+			name: "pr not from github",
+			message: models.OutboxMessage{
+				Topic:   models.TopicReviewerAssigned,
+				Payload: []byte(`{"type":"REVIEWER_ASSIGNED","pull_request_id":"pr-1","user_id":"alice"}`),
+			},
+		},
+		{
+			name: "other topic",
+			message: models.OutboxMessage{
+				Topic:   models.TopicPRMerged,
+				Payload: []byte(`{"type":"PR_MERGED","pull_request_id":"octo/app#42"}`),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeCodeHostClient{}
+			publisher := NewCodeHostPublisher(client)
+
+			err := publisher.Publish(ctx, tt.message)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, client.calls)
+		})
+	}
+}
+
+func TestCodeHostPublisher_Publish_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("request failure stops reassignment", func(t *testing.T) {
+		client := &fakeCodeHostClient{err: errors.New("github is down")}
+		publisher := NewCodeHostPublisher(client)
+
+		err := publisher.Publish(ctx, models.OutboxMessage{
+			Topic:   models.TopicReviewerReassigned,
+			Payload: []byte(`{"pull_request_id":"octo/app#42","user_id":"bob","old_user_id":"alice"}`),
+		})
+
+		require.EqualError(t, err, "github is down")
+		assert.Len(t, client.calls, 1)
+	})
+
+	t.Run("malformed payload is permanent", func(t *testing.T) {
+		publisher := NewCodeHostPublisher(&fakeCodeHostClient{})
+
+		err := publisher.Publish(ctx, models.OutboxMessage{
+			Topic:   models.TopicReviewerAssigned,
+			Payload: []byte(`{`),
+		})
+
+		assert.ErrorIs(t, err, models.ErrPermanentDelivery)
+	})
+}
@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/437d5/pr-review-manager/internal/domain/repositories"
+)
+
+// Publisher delivers outbox messages to subscribers outside of the service
+type Publisher interface {
+	Publish(ctx context.Context, message models.OutboxMessage) error
+}
+
+type DispatcherConfig struct {
+	// Consumer whose outbox messages are dispatched
+	Consumer     string
+	PollInterval time.Duration
+	BatchSize    int
+	// claimed messages are not due again for Lease,
+	// it must outlast publishing of a batch or messages are delivered twice
+	Lease time.Duration
+	// message is dead after MaxAttempts failed deliveries
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+func DefaultDispatcherConfig(consumer string) DispatcherConfig {
+	return DispatcherConfig{
+		Consumer:     consumer,
+		PollInterval: 5 * time.Second,
+		BatchSize:    50,
+		Lease:        5 * time.Minute,
+		MaxAttempts:  10,
+		BaseBackoff:  5 * time.Second,
+		MaxBackoff:   time.Hour,
+	}
+}
+
+// OutboxDispatcher publishes messages written to the outbox by services,
+// delivery is at least once and failed messages are retried with exponential backoff
+type OutboxDispatcher struct {
+	uowFactory func(context.Context) (repositories.UnitOfWork, error)
+	publisher  Publisher
+	cfg        DispatcherConfig
+}
+
+// NewOutboxDispatcher replaces non-positive values of cfg with the defaults
+func NewOutboxDispatcher(
+	uowFactory func(ctx context.Context) (repositories.UnitOfWork, error),
+	publisher Publisher,
+	cfg DispatcherConfig,
+) *OutboxDispatcher {
+	defaults := DefaultDispatcherConfig(cfg.Consumer)
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaults.PollInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaults.BatchSize
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = defaults.Lease
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaults.MaxAttempts
+	}
+	if cfg.BaseBackoff <= 0 {
+		cfg.BaseBackoff = defaults.BaseBackoff
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = defaults.MaxBackoff
+	}
+
+	return &OutboxDispatcher{
+		uowFactory: uowFactory,
+		publisher:  publisher,
+		cfg:        cfg,
+	}
+}
+
+// Run dispatches due messages until ctx is done
+func (d *OutboxDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// drain the backlog before waiting for the next tick, messages of a PR
+		// become due one by one as the older ones are delivered
+		for {
+			n, err := d.Dispatch(ctx)
+			if err != nil || n == 0 {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch claims one batch of due messages, publishes them and returns its size.
+// No transaction is held while subscribers are called, a message whose outcome
+// is not recorded is published again after its lease expires
+func (d *OutboxDispatcher) Dispatch(ctx context.Context) (int, error) {
+	uow, err := d.uowFactory(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer uow.Close()
+
+	messages, err := uow.Outbox().Claim(ctx, d.cfg.Consumer, d.cfg.BatchSize, d.cfg.Lease)
+	if err != nil {
+		slog.Error("cannot claim outbox messages", "error", err.Error(), "consumer", d.cfg.Consumer)
+		return 0, err
+	}
+
+	for _, message := range messages {
+		if err := d.deliver(ctx, uow.Outbox(), message); err != nil {
+			slog.Error("cannot record outbox message outcome", "error", err.Error(), "id", message.ID)
+			return 0, err
+		}
+	}
+
+	return len(messages), nil
+}
+
+// deliver publishes the message and records the outcome,
+// returned error is an error of the outbox itself
+func (d *OutboxDispatcher) deliver(ctx context.Context, outbox repositories.OutboxRepository, message models.OutboxMessage) error {
+	publishErr := d.publisher.Publish(ctx, message)
+	if publishErr == nil {
+		return outbox.MarkDelivered(ctx, message.ID)
+	}
+
+	var partial *models.PartialDeliveryError
+	if errors.As(publishErr, &partial) {
+		if err := outbox.AddDeliveredTargets(ctx, message.ID, partial.Delivered); err != nil {
+			return err
+		}
+	}
+
+	if errors.Is(publishErr, models.ErrDeliveryPostponed) {
+		slog.Warn("outbox message delivery postponed",
+			"id", message.ID,
+			"consumer", message.Consumer,
+			"topic", message.Topic,
+			"retry_in", d.cfg.BaseBackoff,
+			"error", publishErr.Error(),
+		)
+		return outbox.Postpone(ctx, message.ID, publishErr.Error(), d.cfg.BaseBackoff)
+	}
+
+	attempt := message.Attempts + 1
+	if attempt >= d.cfg.MaxAttempts || errors.Is(publishErr, models.ErrPermanentDelivery) {
+		slog.Error("outbox message is dead",
+			"id", message.ID,
+			"consumer", message.Consumer,
+			"topic", message.Topic,
+			"attempts", attempt,
+			"error", publishErr.Error(),
+		)
+		return outbox.MarkDead(ctx, message.ID, publishErr.Error())
+	}
+
+	delay := d.backoff(attempt)
+	slog.Warn("cannot publish outbox message",
+		"id", message.ID,
+		"consumer", message.Consumer,
+		"topic", message.Topic,
+		"attempt", attempt,
+		"retry_in", delay,
+		"error", publishErr.Error(),
+	)
+	return outbox.MarkFailed(ctx, message.ID, publishErr.Error(), delay)
+}
+
+// backoff returns delay before the next attempt after the given failed one
+func (d *OutboxDispatcher) backoff(attempt int) time.Duration {
+	delay := d.cfg.BaseBackoff
+	for i := 1; i < attempt && delay < d.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, d.cfg.MaxBackoff)
+}
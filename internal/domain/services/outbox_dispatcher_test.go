@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/437d5/pr-review-manager/internal/domain/repositories"
+	"github.com/437d5/pr-review-manager/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type publisherFunc func(ctx context.Context, message models.OutboxMessage) error
+
+func (f publisherFunc) Publish(ctx context.Context, message models.OutboxMessage) error {
+	return f(ctx, message)
+}
+
+func TestOutboxDispatcher_Dispatch(t *testing.T) {
+	ctx := context.Background()
+	cfg := DispatcherConfig{
+		Consumer:    models.ConsumerWebhooks,
+		BatchSize:   10,
+		Lease:       time.Minute,
+		MaxAttempts: 3,
+		BaseBackoff: time.Second,
+		MaxBackoff:  time.Minute,
+	}
+
+	mockUOW := &mocks.MockUnitOfWork{}
+	mockOutbox := &mocks.MockOutboxRepository{}
+
+	messages := []models.OutboxMessage{
+		{ID: 1, Topic: models.TopicPRCreated, Attempts: 0},
+		{ID: 2, Topic: models.TopicReviewerAssigned, Attempts: 1},
+		{ID: 3, Topic: models.TopicPRMerged, Attempts: 2},
+	}
+
+	mockUOW.On("Close").Return(nil)
+	mockUOW.On("Outbox").Return(mockOutbox)
+
+	mockOutbox.On("Claim", ctx, models.ConsumerWebhooks, 10, time.Minute).Return(messages, nil)
+	mockOutbox.On("MarkDelivered", ctx, int64(1)).Return(nil).Once()
+	mockOutbox.On("MarkFailed", ctx, int64(2), "receiver is down", 2*time.Second).Return(nil).Once()
+	mockOutbox.On("MarkDead", ctx, int64(3), "receiver is down").Return(nil).Once()
+
+	dispatcher := NewOutboxDispatcher(func(ctx context.Context) (repositories.UnitOfWork, error) {
+		return mockUOW, nil
+	}, publisherFunc(func(ctx context.Context, message models.OutboxMessage) error {
+		if message.ID == 1 {
+			return nil
+		}
+		return errors.New("receiver is down")
+	}), cfg)
+
+	n, err := dispatcher.Dispatch(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	mockOutbox.AssertExpectations(t)
+	mockUOW.AssertExpectations(t)
+}
+
+func TestOutboxDispatcher_Dispatch_PermanentFailure(t *testing.T) {
+	ctx := context.Background()
+	cfg := DispatcherConfig{
+		Consumer:    models.ConsumerCodeHost,
+		BatchSize:   10,
+		Lease:       time.Minute,
+		MaxAttempts: 10,
+		BaseBackoff: time.Second,
+		MaxBackoff:  time.Minute,
+	}
+
+	mockUOW := &mocks.MockUnitOfWork{}
+	mockOutbox := &mocks.MockOutboxRepository{}
+
+	messages := []models.OutboxMessage{
+		{ID: 7, Consumer: models.ConsumerCodeHost, Topic: models.TopicReviewerAssigned},
+	}
+	publishErr := fmt.Errorf("%w: status 422", models.ErrPermanentDelivery)
+
+	mockUOW.On("Close").Return(nil)
+	mockUOW.On("Outbox").Return(mockOutbox)
+
+	mockOutbox.On("Claim", ctx, models.ConsumerCodeHost, 10, time.Minute).Return(messages, nil)
+	mockOutbox.On("MarkDead", ctx, int64(7), publishErr.Error()).Return(nil).Once()
+
+	dispatcher := NewOutboxDispatcher(func(ctx context.Context) (repositories.UnitOfWork, error) {
+		return mockUOW, nil
+	}, publisherFunc(func(ctx context.Context, message models.OutboxMessage) error {
+		return publishErr
+	}), cfg)
+
+	n, err := dispatcher.Dispatch(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	mockOutbox.AssertExpectations(t)
+	mockUOW.AssertExpectations(t)
+}
+
+func TestOutboxDispatcher_Dispatch_Postponed(t *testing.T) {
+	ctx := context.Background()
+	cfg := DispatcherConfig{
+		Consumer:    models.ConsumerCodeHost,
+		BatchSize:   10,
+		Lease:       time.Minute,
+		MaxAttempts: 2,
+		BaseBackoff: time.Second,
+		MaxBackoff:  time.Minute,
+	}
+
+	mockUOW := &mocks.MockUnitOfWork{}
+	mockOutbox := &mocks.MockOutboxRepository{}
+
+	// last attempt is left, postponed delivery must not use it up
+	messages := []models.OutboxMessage{
+		{ID: 8, Consumer: models.ConsumerCodeHost, Topic: models.TopicReviewerAssigned, Attempts: 1},
+	}
+	publishErr := fmt.Errorf("%w: circuit is open", models.ErrDeliveryPostponed)
+
+	mockUOW.On("Close").Return(nil)
+	mockUOW.On("Outbox").Return(mockOutbox)
+
+	mockOutbox.On("Claim", ctx, models.ConsumerCodeHost, 10, time.Minute).Return(messages, nil)
+	mockOutbox.On("Postpone", ctx, int64(8), publishErr.Error(), time.Second).Return(nil).Once()
+
+	dispatcher := NewOutboxDispatcher(func(ctx context.Context) (repositories.UnitOfWork, error) {
+		return mockUOW, nil
+	}, publisherFunc(func(ctx context.Context, message models.OutboxMessage) error {
+		return publishErr
+	}), cfg)
+
+	n, err := dispatcher.Dispatch(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	mockOutbox.AssertExpectations(t)
+	mockUOW.AssertExpectations(t)
+}
+
+func TestOutboxDispatcher_Dispatch_PartialDelivery(t *testing.T) {
+	ctx := context.Background()
+	cfg := DispatcherConfig{
+		Consumer:    models.ConsumerWebhooks,
+		BatchSize:   10,
+		Lease:       time.Minute,
+		MaxAttempts: 3,
+		BaseBackoff: time.Second,
+		MaxBackoff:  time.Minute,
+	}
+
+	mockUOW := &mocks.MockUnitOfWork{}
+	mockOutbox := &mocks.MockOutboxRepository{}
+
+	messages := []models.OutboxMessage{
+		{ID: 9, Consumer: models.ConsumerWebhooks, Topic: models.TopicPRMerged},
+	}
+	publishErr := &models.PartialDeliveryError{
+		Delivered: []string{"https://a.example"},
+		Err:       errors.New("https://b.example: unexpected status 503"),
+	}
+
+	mockUOW.On("Close").Return(nil)
+	mockUOW.On("Outbox").Return(mockOutbox)
+
+	mockOutbox.On("Claim", ctx, models.ConsumerWebhooks, 10, time.Minute).Return(messages, nil)
+	mockOutbox.On("AddDeliveredTargets", ctx, int64(9), []string{"https://a.example"}).Return(nil).Once()
+	mockOutbox.On("MarkFailed", ctx, int64(9), publishErr.Error(), time.Second).Return(nil).Once()
+
+	dispatcher := NewOutboxDispatcher(func(ctx context.Context) (repositories.UnitOfWork, error) {
+		return mockUOW, nil
+	}, publisherFunc(func(ctx context.Context, message models.OutboxMessage) error {
+		return publishErr
+	}), cfg)
+
+	n, err := dispatcher.Dispatch(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	mockOutbox.AssertExpectations(t)
+	mockUOW.AssertExpectations(t)
+}
+
+func TestNewOutboxDispatcher_Defaults(t *testing.T) {
+	dispatcher := NewOutboxDispatcher(nil, nil, DispatcherConfig{
+		Consumer:     models.ConsumerWebhooks,
+		PollInterval: 0,
+		BatchSize:    -1,
+		MaxAttempts:  3,
+	})
+
+	expected := DefaultDispatcherConfig(models.ConsumerWebhooks)
+	expected.MaxAttempts = 3
+	assert.Equal(t, expected, dispatcher.cfg)
+}
+
+func TestOutboxDispatcher_Backoff(t *testing.T) {
+	dispatcher := NewOutboxDispatcher(nil, nil, DispatcherConfig{
+		BaseBackoff: time.Second,
+		MaxBackoff:  10 * time.Second,
+	})
+
+	assert.Equal(t, time.Second, dispatcher.backoff(1))
+	assert.Equal(t, 2*time.Second, dispatcher.backoff(2))
+	assert.Equal(t, 8*time.Second, dispatcher.backoff(4))
+	assert.Equal(t, 10*time.Second, dispatcher.backoff(5))
+	assert.Equal(t, 10*time.Second, dispatcher.backoff(50))
+}
@@ -4,29 +4,49 @@ import (
 	"context"
 	"errors"
 	"log/slog"
-	"math/rand"
+	"slices"
+	"time"
 
 	"github.com/437d5/pr-review-manager/internal/domain/models"
 	"github.com/437d5/pr-review-manager/internal/domain/repositories"
 )
 
-const maxReviewers = 2
-
 type PRService struct {
 	uowFactory func(context.Context) (repositories.UnitOfWork, error)
+	selectors  map[models.ReviewStrategy]ReviewerSelector
+	// now is replaced in tests to pick reviewers by working hours deterministically
+	now func() time.Time
+	// consumers of the outbox that get messages about recorded events
+	consumers []string
 }
 
 func NewPRService(uowFactory func(ctx context.Context) (repositories.UnitOfWork, error)) *PRService {
 	return &PRService{
 		uowFactory: uowFactory,
+		selectors:  defaultSelectors(),
+		now:        time.Now,
+		consumers:  models.OutboxConsumers(),
 	}
 }
 
+// SetOutboxConsumers limits consumers the service puts outbox messages for,
+// messages for a consumer that is not running would never be delivered
+func (s *PRService) SetOutboxConsumers(consumers ...string) {
+	s.consumers = consumers
+}
+
 func (s *PRService) CreatePR(ctx context.Context, pr models.PullRequest) (models.PullRequest, error) {
 	if err := pr.Validate(); err != nil {
 		return models.PullRequest{}, err
 	}
-	pr.Status = models.PRStatusOpen
+	if pr.Status != models.PRStatusDraft {
+		pr.Status = models.PRStatusOpen
+	}
+	pr.ChangedFiles = models.NormalizePaths(pr.ChangedFiles)
+	pr.RequestedReviewers = unique(pr.RequestedReviewers)
+	if len(pr.RequestedReviewers) > models.MaxReviewersCount {
+		return models.PullRequest{}, models.ErrTooManyRequestedReviewers
+	}
 
 	uow, err := s.uowFactory(ctx)
 	if err != nil {
@@ -66,15 +86,19 @@ func (s *PRService) CreatePR(ctx context.Context, pr models.PullRequest) (models
 			return models.ErrPullRequestExists
 		}
 
-		teammates, err := uow.Users().GetActiveTeammatesByUserID(ctx, pr.AuthorID)
-		if err != nil {
-			slog.Error("cannot get teammates", "error", err.Error())
+		if err := validateRequestedReviewers(ctx, uow, pr); err != nil {
 			return err
 		}
 
-		reviewers := s.selectRandomReviewers(teammates, maxReviewers)
-		for _, reviewer := range reviewers {
-			pr.AssignedReviewers = append(pr.AssignedReviewers, reviewer.ID)
+		// draft keeps requested reviewers unassigned, it gets all of them only when it is marked ready
+		if pr.Status == models.PRStatusOpen {
+			pr.AssignedReviewers = slices.Clone(pr.RequestedReviewers)
+			reviewers, fallback, err := s.selectReviewers(ctx, uow, author, pr)
+			if err != nil {
+				return err
+			}
+			pr.AssignedReviewers = append(pr.AssignedReviewers, reviewers...)
+			pr.FallbackReviewers = fallback
 		}
 
 		createdPR, err = uow.PR().Create(ctx, pr)
@@ -83,10 +107,25 @@ func (s *PRService) CreatePR(ctx context.Context, pr models.PullRequest) (models
 			return err
 		}
 
+		events := append(
+			[]models.AssignmentEvent{{Type: models.EventPRCreated, PullRequestID: createdPR.ID, UserID: pr.AuthorID}},
+			assignedEvents(createdPR.ID, pr.AssignedReviewers)...,
+		)
+		if pr.Status == models.PRStatusOpen {
+			for i := range pr.RequestedReviewers {
+				// requested reviewers are assigned first
+				events[i+1].Reason = models.ReasonRequested
+			}
+		}
+		if err := recordEvents(ctx, uow, s.now(), s.consumers, events...); err != nil {
+			return err
+		}
+
 		slog.Info("PR created succefully",
 			"pr_id", createdPR.ID,
 			"author", pr.AuthorID,
-			"reviewers_count", len(reviewers),
+			"status", pr.Status,
+			"reviewers_count", len(pr.AssignedReviewers),
 		)
 
 		return nil
@@ -108,26 +147,297 @@ func (s *PRService) CreatePR(ctx context.Context, pr models.PullRequest) (models
 	return createdPR, nil
 }
 
-func (s *PRService) selectRandomReviewers(users []models.User, max int) []models.User {
-	if len(users) == 0 {
-		return []models.User{}
+// GetPR returns PR with details of assigned reviewers
+func (s *PRService) GetPR(ctx context.Context, ID string) (models.PullRequestDetails, error) {
+	if ID == "" {
+		return models.PullRequestDetails{}, models.ErrPullRequestIDEmpty
+	}
+
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return models.PullRequestDetails{}, err
+	}
+	defer uow.Close()
+
+	pr, err := uow.PR().GetByID(ctx, ID)
+	if err != nil {
+		if !errors.Is(err, models.ErrPullRequestNotFound) {
+			slog.Error("cannot get PR", "error", err.Error(), "pr_id", ID)
+		}
+		return models.PullRequestDetails{}, err
+	}
+
+	reviewers, err := uow.PR().GetReviewerDetails(ctx, ID)
+	if err != nil {
+		slog.Error("cannot get PR reviewers", "error", err.Error(), "pr_id", ID)
+		return models.PullRequestDetails{}, err
+	}
+
+	return models.PullRequestDetails{PullRequest: pr, Reviewers: reviewers}, nil
+}
+
+// ListPRs returns a page of PRs matching the filter
+func (s *PRService) ListPRs(ctx context.Context, filter models.PRFilter) (models.PRPage, error) {
+	if err := filter.Validate(); err != nil {
+		return models.PRPage{}, err
+	}
+
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return models.PRPage{}, err
+	}
+	defer uow.Close()
+
+	page, err := uow.PR().List(ctx, filter)
+	if err != nil {
+		slog.Error("cannot list PRs", "error", err.Error())
+		return models.PRPage{}, err
+	}
+
+	return page, nil
+}
+
+// GetHistory returns audit log of the PR, oldest events first
+func (s *PRService) GetHistory(ctx context.Context, ID string) ([]models.AssignmentEvent, error) {
+	if ID == "" {
+		return nil, models.ErrPullRequestIDEmpty
+	}
+
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer uow.Close()
+
+	if _, err := uow.PR().GetByID(ctx, ID); err != nil {
+		if !errors.Is(err, models.ErrPullRequestNotFound) {
+			slog.Error("cannot get PR", "error", err.Error(), "pr_id", ID)
+		}
+		return nil, err
+	}
+
+	events, err := uow.Events().GetByPR(ctx, ID)
+	if err != nil {
+		slog.Error("cannot get PR history", "error", err.Error(), "pr_id", ID)
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// validateRequestedReviewers checks that requested reviewers of pr exist,
+// are active and are not its author
+func validateRequestedReviewers(ctx context.Context, uow repositories.UnitOfWork, pr models.PullRequest) error {
+	for _, reviewerID := range pr.RequestedReviewers {
+		if reviewerID == "" {
+			return models.ErrEmptyUserID
+		}
+		if reviewerID == pr.AuthorID {
+			return models.ErrRequestedReviewerIsAuthor
+		}
+
+		reviewer, err := uow.Users().GetByID(ctx, reviewerID)
+		if err != nil {
+			if !errors.Is(err, models.ErrUserNotFound) {
+				slog.Error("cannot get requested reviewer", "error", err.Error(), "user_id", reviewerID)
+			}
+			return err
+		}
+		if !reviewer.IsActive {
+			slog.Warn("requested reviewer is not active", "pr_id", pr.ID, "user_id", reviewerID)
+			return models.ErrRequestedReviewerInactive
+		}
+	}
+	return nil
+}
+
+// selectReviewers picks reviewers for the remaining slots of pr among active teammates
+// of the author using strategy of the author's team. Owners of changed files go first,
+// other teammates fill the remaining slots and members of partner teams
+// fill what is left, the latter are returned as fallback too.
+// Users at capacity are skipped, overflow policy of the team decides
+// what to do when the slots cannot be filled without them.
+func (s *PRService) selectReviewers(
+	ctx context.Context,
+	uow repositories.UnitOfWork,
+	author models.User,
+	pr models.PullRequest,
+) ([]string, []string, error) {
+	selector, settings, err := teamSelector(ctx, uow, s.selectors, author.TeamName, s.now())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	count := settings.ReviewersCount - len(pr.AssignedReviewers)
+	if count <= 0 {
+		return nil, nil, nil
+	}
+
+	teammates, err := uow.Users().GetActiveTeammatesByUserID(ctx, author.ID)
+	if err != nil {
+		slog.Error("cannot get teammates", "error", err.Error())
+		return nil, nil, err
+	}
+	teammates, fullTeammates := splitByCapacity(excludeUsers(teammates, pr.AssignedReviewers))
+
+	owners, others := teammates, []models.User{}
+	if len(pr.ChangedFiles) > 0 {
+		rules, err := uow.Teams().GetOwnershipRules(ctx, author.TeamName)
+		if err != nil {
+			slog.Error("cannot get ownership rules", "error", err.Error(), "team", author.TeamName)
+			return nil, nil, err
+		}
+		owners, others = splitOwners(teammates, models.MatchOwners(rules, pr.ChangedFiles))
+	}
+
+	reviewers, err := selector.Select(ctx, uow.PR(), owners, count)
+	if err != nil {
+		slog.Error("cannot select reviewers", "error", err.Error(), "strategy", settings.ReviewStrategy)
+		return nil, nil, err
+	}
+
+	if len(reviewers) < count && len(others) > 0 {
+		rest, err := selector.Select(ctx, uow.PR(), others, count-len(reviewers))
+		if err != nil {
+			slog.Error("cannot select reviewers", "error", err.Error(), "strategy", settings.ReviewStrategy)
+			return nil, nil, err
+		}
+		reviewers = append(reviewers, rest...)
+	}
+
+	if len(reviewers) == count {
+		return userIDs(reviewers), nil, nil
+	}
+
+	partners, err := uow.Users().GetActivePartnersByUserID(ctx, author.ID)
+	if err != nil {
+		slog.Error("cannot get partners", "error", err.Error(), "author_id", author.ID)
+		return nil, nil, err
+	}
+
+	partners, fullPartners := splitByCapacity(excludeUsers(partners, pr.AssignedReviewers))
+
+	fallback, err := selector.Select(ctx, uow.PR(), partners, count-len(reviewers))
+	if err != nil {
+		slog.Error("cannot select fallback reviewers", "error", err.Error(), "strategy", settings.ReviewStrategy)
+		return nil, nil, err
+	}
+
+	missing := count - len(reviewers) - len(fallback)
+	if missing > 0 && len(fullTeammates)+len(fullPartners) > 0 {
+		switch settings.OverflowPolicy {
+		case models.OverflowReject:
+			slog.Warn("reviewers are at capacity", "team", author.TeamName, "missing", missing)
+			return nil, nil, models.ErrReviewersAtCapacity
+		case models.OverflowAssignFewer:
+			slog.Info("PR gets fewer reviewers, the rest are at capacity", "team", author.TeamName, "missing", missing)
+		default:
+			overflow, err := selector.Select(ctx, uow.PR(), fullTeammates, missing)
+			if err != nil {
+				slog.Error("cannot select reviewers", "error", err.Error(), "strategy", settings.ReviewStrategy)
+				return nil, nil, err
+			}
+			reviewers = append(reviewers, overflow...)
+
+			if missing -= len(overflow); missing > 0 {
+				overflow, err = selector.Select(ctx, uow.PR(), fullPartners, missing)
+				if err != nil {
+					slog.Error("cannot select fallback reviewers", "error", err.Error(), "strategy", settings.ReviewStrategy)
+					return nil, nil, err
+				}
+				fallback = append(fallback, overflow...)
+			}
+			slog.Info("reviewers assigned over capacity", "team", author.TeamName)
+		}
+	}
+	if len(fallback) > 0 {
+		slog.Info("reviewers taken from partner teams",
+			"team", author.TeamName,
+			"fallback_reviewers", userIDs(fallback),
+		)
+	}
+
+	return append(userIDs(reviewers), userIDs(fallback)...), userIDs(fallback), nil
+}
+
+// unique returns ids without repeats keeping the first occurrence
+func unique(ids []string) []string {
+	var res []string
+	for _, id := range ids {
+		if !slices.Contains(res, id) {
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+// excludeIDs returns ids except the excluded ones keeping their order
+func excludeIDs(ids, excluded []string) []string {
+	var res []string
+	for _, id := range ids {
+		if !slices.Contains(excluded, id) {
+			res = append(res, id)
+		}
 	}
+	return res
+}
 
-	if len(users) <= max {
+// activeUserIDs returns ids of users that are still active keeping their order
+func activeUserIDs(ctx context.Context, uow repositories.UnitOfWork, ids []string) ([]string, error) {
+	var res []string
+	for _, id := range ids {
+		user, err := uow.Users().GetByID(ctx, id)
+		if err != nil {
+			slog.Error("cannot get user", "error", err.Error(), "user_id", id)
+			return nil, err
+		}
+		if !user.IsActive {
+			slog.Warn("requested reviewer is not active anymore, skipping", "user_id", id)
+			continue
+		}
+		res = append(res, id)
+	}
+	return res, nil
+}
+
+// excludeUsers returns users except the excluded ones keeping their order
+func excludeUsers(users []models.User, excluded []string) []models.User {
+	if len(excluded) == 0 {
 		return users
 	}
 
-	shuffled := make([]models.User, len(users))
-	copy(shuffled, users)
+	res := make([]models.User, 0, len(users))
+	for _, user := range users {
+		if !slices.Contains(excluded, user.ID) {
+			res = append(res, user)
+		}
+	}
+	return res
+}
 
-	rand.Shuffle(len(shuffled), func(i, j int) {
-		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
-	})
+// splitOwners splits candidates into owners and the rest keeping their order,
+// owners who are not candidates are skipped
+func splitOwners(candidates []models.User, ownerIDs []string) ([]models.User, []models.User) {
+	isOwner := make(map[string]struct{}, len(ownerIDs))
+	for _, id := range ownerIDs {
+		isOwner[id] = struct{}{}
+	}
 
-	return shuffled[:max]
+	owners := make([]models.User, 0, len(ownerIDs))
+	others := make([]models.User, 0, len(candidates))
+	for _, candidate := range candidates {
+		if _, ok := isOwner[candidate.ID]; ok {
+			owners = append(owners, candidate)
+		} else {
+			others = append(others, candidate)
+		}
+	}
+	return owners, others
 }
 
-func (s *PRService) Merge(ctx context.Context, ID string) (models.PullRequest, error) {
+// Merge merges PR if it satisfies merge rules of the author's team,
+// force skips the rules and is recorded on the PR
+func (s *PRService) Merge(ctx context.Context, ID string, force bool) (models.PullRequest, error) {
 	if ID == "" {
 		return models.PullRequest{}, models.ErrPullRequestIDEmpty
 	}
@@ -158,14 +468,31 @@ func (s *PRService) Merge(ctx context.Context, ID string) (models.PullRequest, e
 			mergedPR = existingPR
 			return models.ErrPullRequestAlreadyMerged
 		}
+		if existingPR.Status != models.PRStatusOpen {
+			return models.ErrPullRequestNotOpen
+		}
 
-		mergedPR, err = uow.PR().Merge(ctx, ID)
+		if force {
+			slog.Warn("PR merge rules bypassed", "pr_id", ID)
+		} else if err := s.checkMergeRules(ctx, uow, existingPR); err != nil {
+			return err
+		}
+
+		mergedPR, err = uow.PR().Merge(ctx, ID, force)
 		if err != nil {
 			slog.Error("cannot merge PR", "error", err.Error(), "pr_id", ID)
 			return err
 		}
 
-		slog.Info("PR merged successfully", "pr_id", ID)
+		event := models.AssignmentEvent{Type: models.EventPRMerged, PullRequestID: ID}
+		if force {
+			event.Reason = models.ReasonForceMerged
+		}
+		if err := recordEvents(ctx, uow, s.now(), s.consumers, event); err != nil {
+			return err
+		}
+
+		slog.Info("PR merged successfully", "pr_id", ID, "force", force)
 		return nil
 	}()
 
@@ -185,7 +512,40 @@ func (s *PRService) Merge(ctx context.Context, ID string) (models.PullRequest, e
 	return mergedPR, nil
 }
 
-func (s *PRService) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (models.PullRequest, string, error) {
+func (s *PRService) checkMergeRules(ctx context.Context, uow repositories.UnitOfWork, pr models.PullRequest) error {
+	author, err := uow.Users().GetByID(ctx, pr.AuthorID)
+	if err != nil {
+		slog.Error("cannot get author", "error", err.Error(), "author_id", pr.AuthorID)
+		return err
+	}
+
+	settings, err := uow.Teams().GetSettings(ctx, author.TeamName)
+	if err != nil {
+		slog.Error("cannot get team settings", "error", err.Error(), "team_name", author.TeamName)
+		return err
+	}
+
+	if err := pr.CheckMergeRules(settings); err != nil {
+		slog.Warn("PR does not meet merge rules",
+			"pr_id", pr.ID,
+			"team_name", author.TeamName,
+			"required_approvals", settings.RequiredApprovals,
+		)
+		return err
+	}
+
+	return nil
+}
+
+// ReassignReviewer replaces old reviewer with an active teammate,
+// actor and reason of opts are recorded in the audit log.
+// Reviewer requested by the author is replaced only when opts.Force is set.
+func (s *PRService) ReassignReviewer(
+	ctx context.Context,
+	prID string,
+	oldReviewerID string,
+	opts models.ReassignOptions,
+) (models.PullRequest, string, error) {
 	if prID == "" {
 		return models.PullRequest{}, "", models.ErrPullRequestIDEmpty
 	}
@@ -218,7 +578,7 @@ func (s *PRService) ReassignReviewer(ctx context.Context, prID string, oldReview
 			return err
 		}
 
-		_, err = uow.Users().GetByID(ctx, oldReviewerID)
+		oldReviewer, err := uow.Users().GetByID(ctx, oldReviewerID)
 		if err != nil {
 			if errors.Is(err, models.ErrUserNotFound) {
 				return err
@@ -231,6 +591,9 @@ func (s *PRService) ReassignReviewer(ctx context.Context, prID string, oldReview
 		if pr.Status == models.PRStatusMerged {
 			return models.ErrPullRequestAlreadyMerged
 		}
+		if pr.Status != models.PRStatusOpen {
+			return models.ErrPullRequestNotOpen
+		}
 
 		// check if old reviewer in reviewers
 		reviewers, err := uow.PR().GetReviewers(ctx, prID)
@@ -251,31 +614,36 @@ func (s *PRService) ReassignReviewer(ctx context.Context, prID string, oldReview
 			return models.ErrUserNotReviewer
 		}
 
+		if slices.Contains(pr.RequestedReviewers, oldReviewerID) {
+			if !opts.Force {
+				slog.Warn("requested reviewer cannot be replaced without force",
+					"pr_id", prID,
+					"reviewer_id", oldReviewerID,
+				)
+				return models.ErrReviewerRequested
+			}
+			slog.Warn("requested reviewer is replaced by force", "pr_id", prID, "reviewer_id", oldReviewerID)
+		}
+
 		// get teammates except old reviewer
-		candidates, err := uow.Users().GetActiveTeammatesByUserID(ctx, oldReviewerID)
+		teammates, err := uow.Users().GetActiveTeammatesByUserID(ctx, oldReviewerID)
 		if err != nil {
 			slog.Error("cannot get teammates", "error", err.Error(), "user_id", oldReviewerID)
 			return err
 		}
 
-		// exlude old reviewer and author from candidates
-		candidates = s.filterCandidates(candidates, pr, oldReviewerID)
-		// select new random reviewer
-		newReviewer := s.selectRandomReviewers(candidates, 1)
-
-		if len(newReviewer) == 0 {
-			return models.ErrNoCandidateToReassign
+		// select new reviewer with strategy of old reviewer's team
+		selector, settings, err := teamSelector(ctx, uow, s.selectors, oldReviewer.TeamName, s.now())
+		if err != nil {
+			return err
 		}
 
-		updatedPR, err = uow.PR().Reassign(ctx, prID, oldReviewerID, newReviewer[0].ID)
+		updatedPR, newReviewerID, err = reassignReviewer(ctx, uow, selector, settings.OverflowPolicy,
+			pr, oldReviewerID, teammates, opts, s.now(), s.consumers)
 		if err != nil {
-			slog.Error("cannot reassign reviewer", "error", err.Error(), "pr_id", prID, "old_reviewer_id",
-				oldReviewerID, "new_reviewer_id", newReviewer[0].ID)
 			return err
 		}
 
-		newReviewerID = newReviewer[0].ID
-
 		slog.Info("reviewer reassigned successfully",
 			"pr_id", prID,
 			"old_reviewer", oldReviewerID,
@@ -300,23 +668,428 @@ func (s *PRService) ReassignReviewer(ctx context.Context, prID string, oldReview
 	return updatedPR, newReviewerID, nil
 }
 
-// exclude old reviewer and author from candidates
-func (s *PRService) filterCandidates(candidates []models.User, pr models.PullRequest, oldReviewerID string) []models.User {
-	badSet := make(map[string]struct{}, len(pr.AssignedReviewers)+1) // 1 is author id
-	badSet[pr.AuthorID] = struct{}{}
-	badSet[oldReviewerID] = struct{}{}
-	for _, reviewer := range pr.AssignedReviewers {
-		badSet[reviewer] = struct{}{}
+// AddReviewer assigns the user as one more reviewer of a DRAFT or OPEN PR.
+// The user must be active, must not be the author and must be a member
+// of the author's team or of its partner teams.
+func (s *PRService) AddReviewer(ctx context.Context, prID, reviewerID, actorID string) (models.PullRequest, error) {
+	if prID == "" {
+		return models.PullRequest{}, models.ErrPullRequestIDEmpty
+	}
+	if reviewerID == "" {
+		return models.PullRequest{}, models.ErrEmptyUserID
 	}
 
-	res := make([]models.User, 0, len(candidates))
-	for _, candidate := range candidates {
-		if _, ok := badSet[candidate.ID]; ok {
-			continue
-		} else {
-			res = append(res, candidate)
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+	defer uow.Close()
+
+	if err := uow.Begin(ctx); err != nil {
+		slog.Error("cannot begin transaction", "error", err.Error())
+		return models.PullRequest{}, err
+	}
+
+	var updatedPR models.PullRequest
+	err = func() error {
+		pr, err := changeableReviewersPR(ctx, uow, prID)
+		if err != nil {
+			return err
+		}
+
+		if reviewerID == pr.AuthorID {
+			return models.ErrReviewerIsAuthor
+		}
+		if slices.Contains(pr.AssignedReviewers, reviewerID) {
+			return models.ErrReviewerAlreadyAssigned
+		}
+
+		author, err := uow.Users().GetByID(ctx, pr.AuthorID)
+		if err != nil {
+			slog.Error("cannot get author", "error", err.Error(), "author_id", pr.AuthorID)
+			return err
+		}
+
+		settings, err := uow.Teams().GetSettings(ctx, author.TeamName)
+		if err != nil {
+			slog.Error("cannot get team settings", "error", err.Error(), "team", author.TeamName)
+			return err
+		}
+		// reviewers requested on the draft are assigned when it is ready, so they take places too
+		reviewers := len(pr.AssignedReviewers)
+		if pr.Status == models.PRStatusDraft {
+			pending := excludeIDs(pr.RequestedReviewers, append(slices.Clone(pr.AssignedReviewers), reviewerID))
+			reviewers += len(pending)
+		}
+		if reviewers >= settings.ReviewersCount {
+			slog.Warn("pr already has enough reviewers",
+				"pr_id", prID,
+				"reviewers", reviewers,
+				"reviewers_count", settings.ReviewersCount,
+			)
+			return models.ErrTooManyReviewers
+		}
+
+		reviewer, err := uow.Users().GetByID(ctx, reviewerID)
+		if err != nil {
+			if !errors.Is(err, models.ErrUserNotFound) {
+				slog.Error("cannot get user", "error", err.Error(), "user_id", reviewerID)
+			}
+			return err
+		}
+		if !reviewer.IsActive {
+			return models.ErrReviewerInactive
+		}
+		if reviewer.TeamName != author.TeamName {
+			team, err := uow.Teams().GetByName(ctx, author.TeamName)
+			if err != nil {
+				slog.Error("cannot get team", "error", err.Error(), "team", author.TeamName)
+				return err
+			}
+			if !slices.Contains(team.PartnerTeams, reviewer.TeamName) {
+				slog.Warn("reviewer is not in author's team",
+					"pr_id", prID,
+					"reviewer_id", reviewerID,
+					"team", reviewer.TeamName,
+				)
+				return models.ErrReviewerNotInTeam
+			}
+		}
+
+		updatedPR, err = uow.PR().AddReviewer(ctx, prID, reviewerID)
+		if err != nil {
+			if !errors.Is(err, models.ErrReviewerAlreadyAssigned) {
+				slog.Error("cannot add reviewer", "error", err.Error(), "pr_id", prID, "reviewer_id", reviewerID)
+			}
+			return err
+		}
+
+		if err := recordEvents(ctx, uow, s.now(), s.consumers, models.AssignmentEvent{
+			Type:          models.EventReviewerAssigned,
+			PullRequestID: prID,
+			UserID:        reviewerID,
+			ActorID:       actorID,
+		}); err != nil {
+			return err
+		}
+
+		slog.Info("reviewer added", "pr_id", prID, "reviewer", reviewerID, "actor", actorID)
+		return nil
+	}()
+
+	if err != nil {
+		if err := uow.Rollback(); err != nil {
+			slog.Error("cannot rollback transaction", "error", err.Error())
+			return models.PullRequest{}, err
 		}
+		return models.PullRequest{}, err
 	}
 
-	return res
+	if err := uow.Commit(); err != nil {
+		slog.Error("cannot commit transaction", "error", err.Error())
+		return models.PullRequest{}, err
+	}
+
+	return updatedPR, nil
+}
+
+// RemoveReviewer unassigns the reviewer from a DRAFT or OPEN PR without a replacement
+func (s *PRService) RemoveReviewer(ctx context.Context, prID, reviewerID, actorID string) (models.PullRequest, error) {
+	if prID == "" {
+		return models.PullRequest{}, models.ErrPullRequestIDEmpty
+	}
+	if reviewerID == "" {
+		return models.PullRequest{}, models.ErrEmptyUserID
+	}
+
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+	defer uow.Close()
+
+	if err := uow.Begin(ctx); err != nil {
+		slog.Error("cannot begin transaction", "error", err.Error())
+		return models.PullRequest{}, err
+	}
+
+	var updatedPR models.PullRequest
+	err = func() error {
+		if _, err := changeableReviewersPR(ctx, uow, prID); err != nil {
+			return err
+		}
+
+		updatedPR, err = uow.PR().RemoveReviewer(ctx, prID, reviewerID)
+		if err != nil {
+			if !errors.Is(err, models.ErrUserNotReviewer) {
+				slog.Error("cannot remove reviewer", "error", err.Error(), "pr_id", prID, "reviewer_id", reviewerID)
+			}
+			return err
+		}
+
+		if err := recordEvents(ctx, uow, s.now(), s.consumers, models.AssignmentEvent{
+			Type:          models.EventReviewerRemoved,
+			PullRequestID: prID,
+			UserID:        reviewerID,
+			ActorID:       actorID,
+		}); err != nil {
+			return err
+		}
+
+		slog.Info("reviewer removed", "pr_id", prID, "reviewer", reviewerID, "actor", actorID)
+		return nil
+	}()
+
+	if err != nil {
+		if err := uow.Rollback(); err != nil {
+			slog.Error("cannot rollback transaction", "error", err.Error())
+			return models.PullRequest{}, err
+		}
+		return models.PullRequest{}, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		slog.Error("cannot commit transaction", "error", err.Error())
+		return models.PullRequest{}, err
+	}
+
+	return updatedPR, nil
+}
+
+// changeableReviewersPR returns the PR if its reviewers can be changed by hand
+func changeableReviewersPR(ctx context.Context, uow repositories.UnitOfWork, prID string) (models.PullRequest, error) {
+	pr, err := uow.PR().GetByID(ctx, prID)
+	if err != nil {
+		if !errors.Is(err, models.ErrPullRequestNotFound) {
+			slog.Error("cannot get PR", "error", err.Error(), "pr_id", prID)
+		}
+		return models.PullRequest{}, err
+	}
+
+	switch pr.Status {
+	case models.PRStatusMerged:
+		return models.PullRequest{}, models.ErrPullRequestAlreadyMerged
+	case models.PRStatusClosed:
+		return models.PullRequest{}, models.ErrPullRequestNotOpen
+	}
+	return pr, nil
+}
+
+// SubmitReview stores decision of an assigned reviewer on an open PR
+func (s *PRService) SubmitReview(
+	ctx context.Context,
+	prID, reviewerID string,
+	decision models.ReviewDecision,
+) (models.PullRequest, error) {
+	if prID == "" {
+		return models.PullRequest{}, models.ErrPullRequestIDEmpty
+	}
+	if reviewerID == "" {
+		return models.PullRequest{}, models.ErrEmptyUserID
+	}
+	if err := decision.Validate(); err != nil {
+		return models.PullRequest{}, err
+	}
+
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+	defer uow.Close()
+
+	if err := uow.Begin(ctx); err != nil {
+		slog.Error("cannot begin transaction", "error", err.Error())
+		return models.PullRequest{}, err
+	}
+
+	var reviewedPR models.PullRequest
+	err = func() error {
+		pr, err := uow.PR().GetByID(ctx, prID)
+		if err != nil {
+			if errors.Is(err, models.ErrPullRequestNotFound) {
+				return err
+			}
+			slog.Error("cannot get PR", "error", err.Error(), "pr_id", prID)
+			return err
+		}
+
+		if pr.Status == models.PRStatusMerged {
+			return models.ErrPullRequestAlreadyMerged
+		}
+		if pr.Status != models.PRStatusOpen {
+			return models.ErrPullRequestNotOpen
+		}
+
+		reviewedPR, err = uow.PR().SubmitReview(ctx, prID, reviewerID, decision)
+		if err != nil {
+			if errors.Is(err, models.ErrUserNotReviewer) {
+				return err
+			}
+			slog.Error("cannot submit review", "error", err.Error(), "pr_id", prID, "reviewer_id", reviewerID)
+			return err
+		}
+
+		slog.Info("review submitted",
+			"pr_id", prID,
+			"reviewer", reviewerID,
+			"decision", decision,
+		)
+		return nil
+	}()
+
+	if err != nil {
+		if err := uow.Rollback(); err != nil {
+			slog.Error("cannot rollback transaction", "error", err.Error())
+			return models.PullRequest{}, err
+		}
+		return models.PullRequest{}, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		slog.Error("cannot commit transaction", "error", err.Error())
+		return models.PullRequest{}, err
+	}
+
+	return reviewedPR, nil
+}
+
+// MarkReady opens a DRAFT PR for review and assigns reviewers
+func (s *PRService) MarkReady(ctx context.Context, ID string) (models.PullRequest, error) {
+	return s.changeStatus(ctx, ID, models.PRStatusOpen, models.PRStatusDraft)
+}
+
+// Close closes a DRAFT or OPEN PR without merging
+func (s *PRService) Close(ctx context.Context, ID string) (models.PullRequest, error) {
+	return s.changeStatus(ctx, ID, models.PRStatusClosed, models.PRStatusDraft, models.PRStatusOpen)
+}
+
+// Reopen opens a CLOSED PR again, reviewers are kept if it had any
+func (s *PRService) Reopen(ctx context.Context, ID string) (models.PullRequest, error) {
+	return s.changeStatus(ctx, ID, models.PRStatusOpen, models.PRStatusClosed)
+}
+
+// changeStatus moves PR to next status if its current status is one of from,
+// PR that becomes OPEN without reviewers gets them selected
+func (s *PRService) changeStatus(
+	ctx context.Context,
+	ID string,
+	next models.PRStatus,
+	from ...models.PRStatus,
+) (models.PullRequest, error) {
+	if ID == "" {
+		return models.PullRequest{}, models.ErrPullRequestIDEmpty
+	}
+
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+	defer uow.Close()
+
+	if err := uow.Begin(ctx); err != nil {
+		slog.Error("cannot begin transaction", "error", err.Error())
+		return models.PullRequest{}, err
+	}
+
+	var updatedPR models.PullRequest
+	err = func() error {
+		pr, err := uow.PR().GetByID(ctx, ID)
+		if err != nil {
+			if errors.Is(err, models.ErrPullRequestNotFound) {
+				return err
+			}
+			slog.Error("cannot get PR", "error", err.Error(), "pr_id", ID)
+			return err
+		}
+
+		if !slices.Contains(from, pr.Status) {
+			if pr.Status == models.PRStatusMerged {
+				return models.ErrPullRequestAlreadyMerged
+			}
+			if pr.Status == next {
+				return models.ErrPullRequestStatusSame
+			}
+			slog.Warn("PR status transition is not allowed", "pr_id", ID, "from", pr.Status, "to", next)
+			return models.ErrInvalidStatusTransition
+		}
+
+		// reviewers requested on the draft are assigned first, the rest are selected now
+		if next == models.PRStatusOpen && (len(pr.AssignedReviewers) == 0 || pr.Status == models.PRStatusDraft) {
+			author, err := uow.Users().GetByID(ctx, pr.AuthorID)
+			if err != nil {
+				slog.Error("cannot get author", "error", err.Error(), "author_id", pr.AuthorID)
+				return err
+			}
+
+			var events []models.AssignmentEvent
+			if draftRequests := excludeIDs(pr.RequestedReviewers, pr.AssignedReviewers); len(draftRequests) > 0 {
+				requested, err := activeUserIDs(ctx, uow, draftRequests)
+				if err != nil {
+					return err
+				}
+
+				if err := uow.PR().AssignRequestedReviewers(ctx, ID, requested); err != nil {
+					slog.Error("cannot assign requested reviewers", "error", err.Error(), "pr_id", ID)
+					return err
+				}
+
+				pr.AssignedReviewers = append(pr.AssignedReviewers, requested...)
+				events = assignedEvents(ID, requested)
+				for i := range events {
+					events[i].Reason = models.ReasonRequested
+				}
+			}
+
+			reviewers, fallback, err := s.selectReviewers(ctx, uow, author, pr)
+			if err != nil {
+				return err
+			}
+
+			if err := uow.PR().AssignReviewers(ctx, ID, reviewers); err != nil {
+				slog.Error("cannot assign reviewers", "error", err.Error(), "pr_id", ID)
+				return err
+			}
+
+			if len(fallback) > 0 {
+				if err := uow.PR().MarkFallbackReviewers(ctx, ID, fallback); err != nil {
+					slog.Error("cannot mark fallback reviewers", "error", err.Error(), "pr_id", ID)
+					return err
+				}
+			}
+
+			events = append(events, assignedEvents(ID, reviewers)...)
+			if err := recordEvents(ctx, uow, s.now(), s.consumers, events...); err != nil {
+				return err
+			}
+		}
+
+		updatedPR, err = uow.PR().SetStatus(ctx, ID, next)
+		if err != nil {
+			slog.Error("cannot set PR status", "error", err.Error(), "pr_id", ID)
+			return err
+		}
+
+		slog.Info("PR status changed",
+			"pr_id", ID,
+			"from", pr.Status,
+			"to", next,
+			"reviewers_count", len(updatedPR.AssignedReviewers),
+		)
+		return nil
+	}()
+
+	if err != nil {
+		if err := uow.Rollback(); err != nil {
+			slog.Error("cannot rollback transaction", "error", err.Error())
+			return models.PullRequest{}, err
+		}
+		return models.PullRequest{}, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		slog.Error("cannot commit transaction", "error", err.Error())
+		return models.PullRequest{}, err
+	}
+
+	return updatedPR, nil
 }
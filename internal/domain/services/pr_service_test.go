@@ -2,7 +2,11 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/437d5/pr-review-manager/internal/domain/models"
 	"github.com/437d5/pr-review-manager/internal/domain/repositories"
@@ -19,6 +23,8 @@ func TestPRService_CreatePR(t *testing.T) {
 		mockUOW := &mocks.MockUnitOfWork{}
 		mockUsers := &mocks.MockUserRepository{}
 		mockPR := &mocks.MockPRRepository{}
+		mockTeams := &mocks.MockTeamRepository{}
+		mockEvents := &mocks.MockEventRepository{}
 
 		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
 			return mockUOW, nil
@@ -52,11 +58,25 @@ func TestPRService_CreatePR(t *testing.T) {
 		mockUOW.On("Close").Return(nil)
 		mockUOW.On("Users").Return(mockUsers)
 		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockUOW.On("Events").Return(mockEvents)
 
 		mockUsers.On("GetByID", ctx, "user-1").Return(author, nil)
 		mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{}, models.ErrPullRequestNotFound)
 		mockUsers.On("GetActiveTeammatesByUserID", ctx, "user-1").Return(teammates, nil)
-		mockPR.On("Create", ctx, mock.AnythingOfType("models.PullRequest")).Return(expectedPR, nil)
+		mockTeams.On("GetSettings", ctx, "backend").Return(models.DefaultTeamSettings(), nil)
+		mockPR.On("CountOpenReviews", ctx, []string{"user-2", "user-3", "user-4"}).
+			Return(map[string]int{"user-2": 0, "user-3": 1, "user-4": 3}, nil)
+		mockPR.On("Create", ctx, mock.MatchedBy(func(pr models.PullRequest) bool {
+			return assert.ObjectsAreEqual([]string{"user-2", "user-3"}, pr.AssignedReviewers)
+		})).Return(expectedPR, nil)
+		mockEvents.On("Append", ctx, []models.AssignmentEvent{
+			{Type: models.EventPRCreated, PullRequestID: "pr-1", UserID: "user-1"},
+			{Type: models.EventReviewerAssigned, PullRequestID: "pr-1", UserID: "user-2"},
+			{Type: models.EventReviewerAssigned, PullRequestID: "pr-1", UserID: "user-3"},
+		}).Return(nil)
+		mockOutbox := expectOutbox(ctx, mockUOW,
+			models.TopicPRCreated, models.TopicReviewerAssigned, models.TopicReviewerAssigned)
 
 		result, err := service.CreatePR(ctx, pr)
 
@@ -65,6 +85,8 @@ func TestPRService_CreatePR(t *testing.T) {
 		assert.Equal(t, models.PRStatusOpen, result.Status)
 		assert.Len(t, result.AssignedReviewers, 2)
 		mockUOW.AssertExpectations(t)
+		mockEvents.AssertExpectations(t)
+		mockOutbox.AssertExpectations(t)
 	})
 
 	t.Run("author not found", func(t *testing.T) {
@@ -175,223 +197,1705 @@ func TestPRService_CreatePR(t *testing.T) {
 		assert.Equal(t, models.PullRequest{}, result)
 		mockUOW.AssertExpectations(t)
 	})
+
+	t.Run("owners of changed files are preferred", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockPR := &mocks.MockPRRepository{}
+		mockTeams := &mocks.MockTeamRepository{}
+		mockEvents := &mocks.MockEventRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		teammates := []models.User{
+			{ID: "user-2", Username: "reviewer1", IsActive: true},
+			{ID: "user-3", Username: "reviewer2", IsActive: true},
+			{ID: "user-4", Username: "reviewer3", IsActive: true},
+		}
+		rules := []models.OwnershipRule{
+			{Line: 1, Pattern: "*", Owners: []string{"user-3"}},
+			// author and users outside of the team are not candidates
+			{Line: 2, Pattern: "/api/", Owners: []string{"user-4", "user-1", "user-9"}},
+		}
+
+		pr := models.PullRequest{
+			ID:           "pr-1",
+			Name:         "Test PR",
+			AuthorID:     "user-1",
+			ChangedFiles: []string{"./api/handler.go", "api/handler.go"},
+		}
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockUOW.On("Events").Return(mockEvents)
+
+		mockUsers.On("GetByID", ctx, "user-1").Return(models.User{ID: "user-1", TeamName: "backend"}, nil)
+		mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{}, models.ErrPullRequestNotFound)
+		mockUsers.On("GetActiveTeammatesByUserID", ctx, "user-1").Return(teammates, nil)
+		mockTeams.On("GetSettings", ctx, "backend").Return(models.DefaultTeamSettings(), nil)
+		mockTeams.On("GetOwnershipRules", ctx, "backend").Return(rules, nil)
+		mockPR.On("CountOpenReviews", ctx, []string{"user-4"}).Return(map[string]int{"user-4": 5}, nil)
+		mockPR.On("CountOpenReviews", ctx, []string{"user-2", "user-3"}).
+			Return(map[string]int{"user-2": 0, "user-3": 1}, nil)
+		mockPR.On("Create", ctx, mock.MatchedBy(func(pr models.PullRequest) bool {
+			return assert.ObjectsAreEqual([]string{"user-4", "user-2"}, pr.AssignedReviewers) &&
+				assert.ObjectsAreEqual([]string{"api/handler.go"}, pr.ChangedFiles)
+		})).Return(models.PullRequest{ID: "pr-1", AssignedReviewers: []string{"user-4", "user-2"}}, nil)
+		mockEvents.On("Append", ctx, mock.Anything).Return(nil)
+		expectOutbox(ctx, mockUOW,
+			models.TopicPRCreated, models.TopicReviewerAssigned, models.TopicReviewerAssigned)
+
+		result, err := service.CreatePR(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user-4", "user-2"}, result.AssignedReviewers)
+		mockTeams.AssertExpectations(t)
+		mockPR.AssertExpectations(t)
+	})
+
+	t.Run("partner teams fill missing reviewers", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockPR := &mocks.MockPRRepository{}
+		mockTeams := &mocks.MockTeamRepository{}
+		mockEvents := &mocks.MockEventRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		pr := models.PullRequest{ID: "pr-1", Name: "Test PR", AuthorID: "user-1"}
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockUOW.On("Events").Return(mockEvents)
+
+		mockUsers.On("GetByID", ctx, "user-1").Return(models.User{ID: "user-1", TeamName: "backend"}, nil)
+		mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{}, models.ErrPullRequestNotFound)
+		mockUsers.On("GetActiveTeammatesByUserID", ctx, "user-1").
+			Return([]models.User{{ID: "user-2", TeamName: "backend", IsActive: true}}, nil)
+		mockUsers.On("GetActivePartnersByUserID", ctx, "user-1").Return([]models.User{
+			{ID: "user-7", TeamName: "platform", IsActive: true},
+			{ID: "user-8", TeamName: "platform", IsActive: true},
+		}, nil)
+		mockTeams.On("GetSettings", ctx, "backend").Return(models.DefaultTeamSettings(), nil)
+		mockPR.On("CountOpenReviews", ctx, []string{"user-2"}).Return(map[string]int{}, nil)
+		mockPR.On("CountOpenReviews", ctx, []string{"user-7", "user-8"}).
+			Return(map[string]int{"user-7": 4, "user-8": 1}, nil)
+		mockPR.On("Create", ctx, mock.MatchedBy(func(pr models.PullRequest) bool {
+			return assert.ObjectsAreEqual([]string{"user-2", "user-8"}, pr.AssignedReviewers) &&
+				assert.ObjectsAreEqual([]string{"user-8"}, pr.FallbackReviewers)
+		})).Return(models.PullRequest{
+			ID:                "pr-1",
+			AssignedReviewers: []string{"user-2", "user-8"},
+			FallbackReviewers: []string{"user-8"},
+		}, nil)
+		mockEvents.On("Append", ctx, mock.Anything).Return(nil)
+		expectOutbox(ctx, mockUOW,
+			models.TopicPRCreated, models.TopicReviewerAssigned, models.TopicReviewerAssigned)
+
+		result, err := service.CreatePR(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user-8"}, result.FallbackReviewers)
+		mockUsers.AssertExpectations(t)
+		mockPR.AssertExpectations(t)
+	})
+
+	t.Run("draft gets no reviewers", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockPR := &mocks.MockPRRepository{}
+		mockEvents := &mocks.MockEventRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		pr := models.PullRequest{
+			ID:       "pr-1",
+			Name:     "Test PR",
+			AuthorID: "user-1",
+			Status:   models.PRStatusDraft,
+		}
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("PR").Return(mockPR)
+
+		mockUsers.On("GetByID", ctx, "user-1").Return(models.User{ID: "user-1", TeamName: "backend"}, nil)
+		mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{}, models.ErrPullRequestNotFound)
+		mockPR.On("Create", ctx, pr).Return(pr, nil)
+		mockUOW.On("Events").Return(mockEvents)
+		mockEvents.On("Append", ctx, []models.AssignmentEvent{
+			{Type: models.EventPRCreated, PullRequestID: "pr-1", UserID: "user-1"},
+		}).Return(nil)
+		expectOutbox(ctx, mockUOW, models.TopicPRCreated)
+
+		result, err := service.CreatePR(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.PRStatusDraft, result.Status)
+		assert.Empty(t, result.AssignedReviewers)
+		mockUsers.AssertNotCalled(t, "GetActiveTeammatesByUserID", ctx, "user-1")
+		mockUOW.AssertExpectations(t)
+	})
+
+	t.Run("draft keeps requested reviewers unassigned", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockPR := &mocks.MockPRRepository{}
+		mockEvents := &mocks.MockEventRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		pr := models.PullRequest{
+			ID:                 "pr-1",
+			Name:               "Test PR",
+			AuthorID:           "user-1",
+			Status:             models.PRStatusDraft,
+			RequestedReviewers: []string{"user-4"},
+		}
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("PR").Return(mockPR)
+
+		mockUsers.On("GetByID", ctx, "user-1").Return(models.User{ID: "user-1", TeamName: "backend"}, nil)
+		mockUsers.On("GetByID", ctx, "user-4").Return(models.User{ID: "user-4", IsActive: true}, nil)
+		mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{}, models.ErrPullRequestNotFound)
+		mockPR.On("Create", ctx, pr).Return(pr, nil)
+		mockUOW.On("Events").Return(mockEvents)
+		mockEvents.On("Append", ctx, []models.AssignmentEvent{
+			{Type: models.EventPRCreated, PullRequestID: "pr-1", UserID: "user-1"},
+		}).Return(nil)
+		expectOutbox(ctx, mockUOW, models.TopicPRCreated)
+
+		result, err := service.CreatePR(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.AssignedReviewers)
+		assert.Equal(t, []string{"user-4"}, result.RequestedReviewers)
+		mockPR.AssertExpectations(t)
+		mockEvents.AssertExpectations(t)
+	})
+
+	t.Run("requested reviewers are assigned first", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockPR := &mocks.MockPRRepository{}
+		mockTeams := &mocks.MockTeamRepository{}
+		mockEvents := &mocks.MockEventRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		pr := models.PullRequest{
+			ID:                 "pr-1",
+			Name:               "Test PR",
+			AuthorID:           "user-1",
+			RequestedReviewers: []string{"user-4", "user-4"},
+		}
+
+		teammates := []models.User{
+			{ID: "user-2", IsActive: true},
+			{ID: "user-3", IsActive: true},
+			{ID: "user-4", IsActive: true},
+		}
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockUOW.On("Events").Return(mockEvents)
+
+		mockUsers.On("GetByID", ctx, "user-1").Return(models.User{ID: "user-1", TeamName: "backend"}, nil)
+		mockUsers.On("GetByID", ctx, "user-4").Return(models.User{ID: "user-4", IsActive: true}, nil)
+		mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{}, models.ErrPullRequestNotFound)
+		mockUsers.On("GetActiveTeammatesByUserID", ctx, "user-1").Return(teammates, nil)
+		mockTeams.On("GetSettings", ctx, "backend").Return(models.DefaultTeamSettings(), nil)
+		mockPR.On("CountOpenReviews", ctx, []string{"user-2", "user-3"}).
+			Return(map[string]int{"user-2": 2, "user-3": 0}, nil)
+		mockPR.On("Create", ctx, mock.MatchedBy(func(pr models.PullRequest) bool {
+			return assert.ObjectsAreEqual([]string{"user-4", "user-3"}, pr.AssignedReviewers) &&
+				assert.ObjectsAreEqual([]string{"user-4"}, pr.RequestedReviewers)
+		})).Return(models.PullRequest{
+			ID:                 "pr-1",
+			Status:             models.PRStatusOpen,
+			AssignedReviewers:  []string{"user-4", "user-3"},
+			RequestedReviewers: []string{"user-4"},
+		}, nil)
+		mockEvents.On("Append", ctx, []models.AssignmentEvent{
+			{Type: models.EventPRCreated, PullRequestID: "pr-1", UserID: "user-1"},
+			{Type: models.EventReviewerAssigned, PullRequestID: "pr-1", UserID: "user-4", Reason: models.ReasonRequested},
+			{Type: models.EventReviewerAssigned, PullRequestID: "pr-1", UserID: "user-3"},
+		}).Return(nil)
+		expectOutbox(ctx, mockUOW,
+			models.TopicPRCreated, models.TopicReviewerAssigned, models.TopicReviewerAssigned)
+
+		result, err := service.CreatePR(ctx, pr)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user-4"}, result.RequestedReviewers)
+		mockPR.AssertExpectations(t)
+		mockEvents.AssertExpectations(t)
+	})
+
+	t.Run("invalid requested reviewers", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			requested []string
+			reviewer  models.User
+			expected  error
+		}{
+			{"author", []string{"user-1"}, models.User{}, models.ErrRequestedReviewerIsAuthor},
+			{"empty id", []string{""}, models.User{}, models.ErrEmptyUserID},
+			{"inactive", []string{"user-2"}, models.User{ID: "user-2"}, models.ErrRequestedReviewerInactive},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockUOW := &mocks.MockUnitOfWork{}
+				mockUsers := &mocks.MockUserRepository{}
+				mockPR := &mocks.MockPRRepository{}
+
+				service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+					return mockUOW, nil
+				})
+
+				mockUOW.On("Begin", ctx).Return(nil)
+				mockUOW.On("Rollback").Return(nil)
+				mockUOW.On("Close").Return(nil)
+				mockUOW.On("Users").Return(mockUsers)
+				mockUOW.On("PR").Return(mockPR)
+
+				mockUsers.On("GetByID", ctx, "user-1").Return(models.User{ID: "user-1", TeamName: "backend"}, nil)
+				mockUsers.On("GetByID", ctx, "user-2").Return(tt.reviewer, nil)
+				mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{}, models.ErrPullRequestNotFound)
+
+				_, err := service.CreatePR(ctx, models.PullRequest{
+					ID:                 "pr-1",
+					Name:               "Test PR",
+					AuthorID:           "user-1",
+					RequestedReviewers: tt.requested,
+				})
+
+				assert.Equal(t, tt.expected, err)
+				mockPR.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			})
+		}
+	})
+
+	t.Run("teammates within working hours are preferred", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockPR := &mocks.MockPRRepository{}
+		mockTeams := &mocks.MockTeamRepository{}
+		mockEvents := &mocks.MockEventRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+		// 21:00 in Tokyo and 15:00 in Moscow
+		now := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+		service.now = func() time.Time { return now }
+
+		dayShift := &models.WorkingHours{Start: "09:00", End: "18:00"}
+		teammates := []models.User{
+			{ID: "user-2", IsActive: true, Timezone: "Asia/Tokyo", WorkingHours: dayShift},
+			{ID: "user-3", IsActive: true, Timezone: "Europe/Moscow", WorkingHours: dayShift},
+		}
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockUOW.On("Events").Return(mockEvents)
+
+		mockUsers.On("GetByID", ctx, "user-1").Return(models.User{ID: "user-1", TeamName: "backend"}, nil)
+		mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{}, models.ErrPullRequestNotFound)
+		mockUsers.On("GetActiveTeammatesByUserID", ctx, "user-1").Return(teammates, nil)
+		mockTeams.On("GetSettings", ctx, "backend").Return(models.TeamSettings{
+			ReviewStrategy: models.ReviewStrategyRoundRobin,
+			ReviewersCount: 1,
+		}, nil)
+		// round robin alone would pick user-2 who was never assigned
+		mockPR.On("GetLastAssignedAt", ctx, []string{"user-3"}).
+			Return(map[string]time.Time{"user-3": now.Add(-time.Hour)}, nil)
+		mockPR.On("Create", ctx, mock.MatchedBy(func(pr models.PullRequest) bool {
+			return assert.Equal(t, []string{"user-3"}, pr.AssignedReviewers)
+		})).Return(models.PullRequest{ID: "pr-1"}, nil)
+		mockEvents.On("Append", ctx, mock.Anything).Return(nil)
+		mockOutbox := expectOutbox(ctx, mockUOW, models.TopicPRCreated, models.TopicReviewerAssigned)
+
+		_, err := service.CreatePR(ctx, models.PullRequest{ID: "pr-1", Name: "Test PR", AuthorID: "user-1"})
+
+		require.NoError(t, err)
+		mockPR.AssertExpectations(t)
+
+		// events are stamped with the service clock
+		messages := mockOutbox.Calls[0].Arguments.Get(1).([]models.OutboxMessage)
+		var event models.DomainEvent
+		require.NoError(t, json.Unmarshal(messages[0].Payload, &event))
+		assert.Equal(t, "2025-07-01T12:00:00Z", event.OccurredAt)
+	})
+
+	t.Run("reviewers at capacity are skipped", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockPR := &mocks.MockPRRepository{}
+		mockTeams := &mocks.MockTeamRepository{}
+		mockEvents := &mocks.MockEventRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		limit := 1
+		teammates := []models.User{
+			{ID: "user-2", IsActive: true, MaxOpenReviews: &limit, OpenReviews: 1},
+			{ID: "user-3", IsActive: true, MaxOpenReviews: &limit},
+			{ID: "user-4", IsActive: true, OpenReviews: 7},
+		}
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockUOW.On("Events").Return(mockEvents)
+
+		mockUsers.On("GetByID", ctx, "user-1").Return(models.User{ID: "user-1", TeamName: "backend"}, nil)
+		mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{}, models.ErrPullRequestNotFound)
+		mockUsers.On("GetActiveTeammatesByUserID", ctx, "user-1").Return(teammates, nil)
+		mockTeams.On("GetSettings", ctx, "backend").Return(models.TeamSettings{
+			ReviewStrategy: models.ReviewStrategyRandom,
+			ReviewersCount: 2,
+			OverflowPolicy: models.OverflowReject,
+		}, nil)
+		mockPR.On("Create", ctx, mock.MatchedBy(func(pr models.PullRequest) bool {
+			return assert.ElementsMatch(t, []string{"user-3", "user-4"}, pr.AssignedReviewers)
+		})).Return(models.PullRequest{ID: "pr-1"}, nil)
+		mockEvents.On("Append", ctx, mock.Anything).Return(nil)
+		expectOutbox(ctx, mockUOW,
+			models.TopicPRCreated, models.TopicReviewerAssigned, models.TopicReviewerAssigned)
+
+		_, err := service.CreatePR(ctx, models.PullRequest{ID: "pr-1", Name: "Test PR", AuthorID: "user-1"})
+
+		require.NoError(t, err)
+		mockPR.AssertExpectations(t)
+		mockUsers.AssertNotCalled(t, "GetActivePartnersByUserID", ctx, "user-1")
+	})
+
+	t.Run("overflow policy", func(t *testing.T) {
+		tests := []struct {
+			policy    models.OverflowPolicy
+			reviewers []string
+			expected  error
+		}{
+			{models.OverflowAssignAnyway, []string{"user-3", "user-2"}, nil},
+			{models.OverflowAssignFewer, []string{"user-3"}, nil},
+			{models.OverflowReject, nil, models.ErrReviewersAtCapacity},
+		}
+
+		for _, tt := range tests {
+			t.Run(string(tt.policy), func(t *testing.T) {
+				mockUOW := &mocks.MockUnitOfWork{}
+				mockUsers := &mocks.MockUserRepository{}
+				mockPR := &mocks.MockPRRepository{}
+				mockTeams := &mocks.MockTeamRepository{}
+				mockEvents := &mocks.MockEventRepository{}
+
+				service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+					return mockUOW, nil
+				})
+
+				limit := 2
+				teammates := []models.User{
+					{ID: "user-2", IsActive: true, MaxOpenReviews: &limit, OpenReviews: 2},
+					{ID: "user-3", IsActive: true},
+				}
+
+				mockUOW.On("Begin", ctx).Return(nil)
+				mockUOW.On("Commit").Return(nil)
+				mockUOW.On("Rollback").Return(nil)
+				mockUOW.On("Close").Return(nil)
+				mockUOW.On("Users").Return(mockUsers)
+				mockUOW.On("PR").Return(mockPR)
+				mockUOW.On("Teams").Return(mockTeams)
+				mockUOW.On("Events").Return(mockEvents)
+
+				mockUsers.On("GetByID", ctx, "user-1").Return(models.User{ID: "user-1", TeamName: "backend"}, nil)
+				mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{}, models.ErrPullRequestNotFound)
+				mockUsers.On("GetActiveTeammatesByUserID", ctx, "user-1").Return(teammates, nil)
+				mockUsers.On("GetActivePartnersByUserID", ctx, "user-1").Return([]models.User{}, nil)
+				mockTeams.On("GetSettings", ctx, "backend").Return(models.TeamSettings{
+					ReviewStrategy: models.ReviewStrategyRandom,
+					ReviewersCount: 2,
+					OverflowPolicy: tt.policy,
+				}, nil)
+				mockPR.On("Create", ctx, mock.Anything).Return(models.PullRequest{ID: "pr-1"}, nil)
+				mockEvents.On("Append", ctx, mock.Anything).Return(nil)
+				mockOutbox := &mocks.MockOutboxRepository{}
+				mockUOW.On("Outbox").Return(mockOutbox)
+				mockOutbox.On("Add", ctx, mock.Anything).Return(nil)
+
+				_, err := service.CreatePR(ctx, models.PullRequest{ID: "pr-1", Name: "Test PR", AuthorID: "user-1"})
+
+				assert.Equal(t, tt.expected, err)
+				if tt.expected != nil {
+					mockPR.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+					return
+				}
+				mockPR.AssertCalled(t, "Create", ctx, mock.MatchedBy(func(pr models.PullRequest) bool {
+					return assert.ObjectsAreEqual(tt.reviewers, pr.AssignedReviewers)
+				}))
+			})
+		}
+	})
+
+	t.Run("too many requested reviewers", func(t *testing.T) {
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			t.Fatal("unit of work must not be created")
+			return nil, nil
+		})
+
+		requested := make([]string, models.MaxReviewersCount+1)
+		for i := range requested {
+			requested[i] = fmt.Sprintf("user-%d", i+2)
+		}
+
+		_, err := service.CreatePR(ctx, models.PullRequest{
+			ID:                 "pr-1",
+			Name:               "Test PR",
+			AuthorID:           "user-1",
+			RequestedReviewers: requested,
+		})
+
+		assert.Equal(t, models.ErrTooManyRequestedReviewers, err)
+	})
+}
+
+func TestPRService_MarkReady(t *testing.T) {
+	ctx := context.Background()
+
+	mockUOW := &mocks.MockUnitOfWork{}
+	mockUsers := &mocks.MockUserRepository{}
+	mockPR := &mocks.MockPRRepository{}
+	mockTeams := &mocks.MockTeamRepository{}
+	mockEvents := &mocks.MockEventRepository{}
+
+	service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+		return mockUOW, nil
+	})
+
+	draft := models.PullRequest{ID: "pr-1", Name: "Test PR", AuthorID: "user-1", Status: models.PRStatusDraft}
+	opened := draft
+	opened.Status = models.PRStatusOpen
+	opened.AssignedReviewers = []string{"user-2", "user-3"}
+
+	mockUOW.On("Begin", ctx).Return(nil)
+	mockUOW.On("Commit").Return(nil)
+	mockUOW.On("Close").Return(nil)
+	mockUOW.On("Users").Return(mockUsers)
+	mockUOW.On("PR").Return(mockPR)
+	mockUOW.On("Teams").Return(mockTeams)
+
+	mockPR.On("GetByID", ctx, "pr-1").Return(draft, nil)
+	mockUsers.On("GetByID", ctx, "user-1").Return(models.User{ID: "user-1", TeamName: "backend"}, nil)
+	mockUsers.On("GetActiveTeammatesByUserID", ctx, "user-1").Return([]models.User{{ID: "user-2"}, {ID: "user-3"}}, nil)
+	mockTeams.On("GetSettings", ctx, "backend").Return(models.DefaultTeamSettings(), nil)
+	mockPR.On("CountOpenReviews", ctx, []string{"user-2", "user-3"}).
+		Return(map[string]int{}, nil)
+	mockPR.On("AssignReviewers", ctx, "pr-1", mock.MatchedBy(func(ids []string) bool {
+		return assert.ObjectsAreEqual([]string{"user-2", "user-3"}, slices.Sorted(slices.Values(ids)))
+	})).Return(nil)
+	mockPR.On("SetStatus", ctx, "pr-1", models.PRStatusOpen).Return(opened, nil)
+	mockUOW.On("Events").Return(mockEvents)
+	mockEvents.On("Append", ctx, mock.MatchedBy(func(events []models.AssignmentEvent) bool {
+		return len(events) == 2 &&
+			events[0].Type == models.EventReviewerAssigned &&
+			events[1].Type == models.EventReviewerAssigned
+	})).Return(nil)
+	expectOutbox(ctx, mockUOW, models.TopicReviewerAssigned, models.TopicReviewerAssigned)
+
+	result, err := service.MarkReady(ctx, "pr-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, models.PRStatusOpen, result.Status)
+	assert.Len(t, result.AssignedReviewers, 2)
+	mockPR.AssertExpectations(t)
+	mockUOW.AssertExpectations(t)
+	mockEvents.AssertExpectations(t)
+}
+
+func TestPRService_MarkReady_RequestedReviewers(t *testing.T) {
+	ctx := context.Background()
+
+	mockUOW := &mocks.MockUnitOfWork{}
+	mockUsers := &mocks.MockUserRepository{}
+	mockPR := &mocks.MockPRRepository{}
+	mockTeams := &mocks.MockTeamRepository{}
+	mockEvents := &mocks.MockEventRepository{}
+
+	service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+		return mockUOW, nil
+	})
+
+	// user-5 was deactivated after the draft was created
+	draft := models.PullRequest{
+		ID:                 "pr-1",
+		Name:               "Test PR",
+		AuthorID:           "user-1",
+		Status:             models.PRStatusDraft,
+		RequestedReviewers: []string{"user-4", "user-5"},
+	}
+	opened := draft
+	opened.Status = models.PRStatusOpen
+	opened.AssignedReviewers = []string{"user-4", "user-2"}
+	opened.RequestedReviewers = []string{"user-4"}
+
+	mockUOW.On("Begin", ctx).Return(nil)
+	mockUOW.On("Commit").Return(nil)
+	mockUOW.On("Close").Return(nil)
+	mockUOW.On("Users").Return(mockUsers)
+	mockUOW.On("PR").Return(mockPR)
+	mockUOW.On("Teams").Return(mockTeams)
+	mockUOW.On("Events").Return(mockEvents)
+
+	mockPR.On("GetByID", ctx, "pr-1").Return(draft, nil)
+	mockUsers.On("GetByID", ctx, "user-1").Return(models.User{ID: "user-1", TeamName: "backend"}, nil)
+	mockUsers.On("GetByID", ctx, "user-4").Return(models.User{ID: "user-4", IsActive: true}, nil)
+	mockUsers.On("GetByID", ctx, "user-5").Return(models.User{ID: "user-5"}, nil)
+	mockPR.On("AssignRequestedReviewers", ctx, "pr-1", []string{"user-4"}).Return(nil).Once()
+	mockUsers.On("GetActiveTeammatesByUserID", ctx, "user-1").
+		Return([]models.User{{ID: "user-2"}, {ID: "user-4"}}, nil)
+	mockTeams.On("GetSettings", ctx, "backend").Return(models.DefaultTeamSettings(), nil)
+	mockPR.On("CountOpenReviews", ctx, []string{"user-2"}).Return(map[string]int{}, nil)
+	mockPR.On("AssignReviewers", ctx, "pr-1", []string{"user-2"}).Return(nil).Once()
+	mockPR.On("SetStatus", ctx, "pr-1", models.PRStatusOpen).Return(opened, nil)
+	mockEvents.On("Append", ctx, []models.AssignmentEvent{
+		{Type: models.EventReviewerAssigned, PullRequestID: "pr-1", UserID: "user-4", Reason: models.ReasonRequested},
+		{Type: models.EventReviewerAssigned, PullRequestID: "pr-1", UserID: "user-2"},
+	}).Return(nil)
+	expectOutbox(ctx, mockUOW, models.TopicReviewerAssigned, models.TopicReviewerAssigned)
+
+	result, err := service.MarkReady(ctx, "pr-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user-4", "user-2"}, result.AssignedReviewers)
+	mockPR.AssertExpectations(t)
+	mockEvents.AssertExpectations(t)
+}
+
+func TestPRService_ChangeStatus_NotAllowed(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		current  models.PRStatus
+		change   func(s *PRService) (models.PullRequest, error)
+		expected error
+	}{
+		{
+			name:    "ready on open PR",
+			current: models.PRStatusOpen,
+			change: func(s *PRService) (models.PullRequest, error) {
+				return s.MarkReady(ctx, "pr-1")
+			},
+			expected: models.ErrPullRequestStatusSame,
+		},
+		{
+			name:    "ready on closed PR",
+			current: models.PRStatusClosed,
+			change: func(s *PRService) (models.PullRequest, error) {
+				return s.MarkReady(ctx, "pr-1")
+			},
+			expected: models.ErrInvalidStatusTransition,
+		},
+		{
+			name:    "close merged PR",
+			current: models.PRStatusMerged,
+			change: func(s *PRService) (models.PullRequest, error) {
+				return s.Close(ctx, "pr-1")
+			},
+			expected: models.ErrPullRequestAlreadyMerged,
+		},
+		{
+			name:    "reopen open PR",
+			current: models.PRStatusOpen,
+			change: func(s *PRService) (models.PullRequest, error) {
+				return s.Reopen(ctx, "pr-1")
+			},
+			expected: models.ErrPullRequestStatusSame,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUOW := &mocks.MockUnitOfWork{}
+			mockPR := &mocks.MockPRRepository{}
+
+			service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+				return mockUOW, nil
+			})
+
+			mockUOW.On("Begin", ctx).Return(nil)
+			mockUOW.On("Rollback").Return(nil)
+			mockUOW.On("Close").Return(nil)
+			mockUOW.On("PR").Return(mockPR)
+
+			mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{ID: "pr-1", Status: tt.current}, nil)
+
+			_, err := tt.change(service)
+
+			assert.Equal(t, tt.expected, err)
+			mockPR.AssertNotCalled(t, "SetStatus", ctx, "pr-1", mock.Anything)
+			mockUOW.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPRService_Reopen_KeepsReviewers(t *testing.T) {
+	ctx := context.Background()
+
+	mockUOW := &mocks.MockUnitOfWork{}
+	mockPR := &mocks.MockPRRepository{}
+
+	service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+		return mockUOW, nil
+	})
+
+	closed := models.PullRequest{
+		ID:                "pr-1",
+		AuthorID:          "user-1",
+		Status:            models.PRStatusClosed,
+		AssignedReviewers: []string{"user-2"},
+	}
+	reopened := closed
+	reopened.Status = models.PRStatusOpen
+
+	mockUOW.On("Begin", ctx).Return(nil)
+	mockUOW.On("Commit").Return(nil)
+	mockUOW.On("Close").Return(nil)
+	mockUOW.On("PR").Return(mockPR)
+
+	mockPR.On("GetByID", ctx, "pr-1").Return(closed, nil)
+	mockPR.On("SetStatus", ctx, "pr-1", models.PRStatusOpen).Return(reopened, nil)
+
+	result, err := service.Reopen(ctx, "pr-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user-2"}, result.AssignedReviewers)
+	mockPR.AssertNotCalled(t, "AssignReviewers", mock.Anything, mock.Anything, mock.Anything)
+	mockUOW.AssertExpectations(t)
+}
+
+func TestPRService_GetPR(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns reviewer details", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		pr := models.PullRequest{ID: "pr-1", Status: models.PRStatusOpen, AssignedReviewers: []string{"u2"}}
+		reviewers := []models.Reviewer{
+			{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true, AssignedAt: "2025-10-24T12:00:00Z"},
+		}
+
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+		mockPR.On("GetByID", ctx, "pr-1").Return(pr, nil)
+		mockPR.On("GetReviewerDetails", ctx, "pr-1").Return(reviewers, nil)
+
+		result, err := service.GetPR(ctx, "pr-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, pr, result.PullRequest)
+		assert.Equal(t, reviewers, result.Reviewers)
+	})
+
+	t.Run("pr not found", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+		mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{}, models.ErrPullRequestNotFound)
+
+		_, err := service.GetPR(ctx, "pr-1")
+
+		assert.Equal(t, models.ErrPullRequestNotFound, err)
+		mockPR.AssertNotCalled(t, "GetReviewerDetails", ctx, "pr-1")
+	})
+}
+
+func TestPRService_GetHistory(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns events of the PR", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+		mockEvents := &mocks.MockEventRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		events := []models.AssignmentEvent{
+			{ID: 1, Type: models.EventPRCreated, PullRequestID: "pr-1", UserID: "user-1"},
+			{ID: 2, Type: models.EventReviewerAssigned, PullRequestID: "pr-1", UserID: "user-2"},
+		}
+
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Events").Return(mockEvents)
+		mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{ID: "pr-1"}, nil)
+		mockEvents.On("GetByPR", ctx, "pr-1").Return(events, nil)
+
+		result, err := service.GetHistory(ctx, "pr-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, events, result)
+	})
+
+	t.Run("pr not found", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+		mockPR.On("GetByID", ctx, "missing").Return(models.PullRequest{}, models.ErrPullRequestNotFound)
+
+		_, err := service.GetHistory(ctx, "missing")
+
+		assert.Equal(t, models.ErrPullRequestNotFound, err)
+	})
+}
+
+func TestPRService_ListPRs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("passes filter to repository", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		filter := models.PRFilter{Status: models.PRStatusOpen, TeamName: "backend", Limit: 2}
+		page := models.PRPage{
+			PullRequests: []models.PullRequest{{ID: "pr-2"}, {ID: "pr-1"}},
+			NextCursor:   "next",
+		}
+
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+		mockPR.On("List", ctx, filter).Return(page, nil)
+
+		result, err := service.ListPRs(ctx, filter)
+
+		require.NoError(t, err)
+		assert.Equal(t, page, result)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			t.Fatal("unit of work must not be created")
+			return nil, nil
+		})
+
+		_, err := service.ListPRs(ctx, models.PRFilter{Status: "UNKNOWN"})
+
+		assert.Equal(t, models.ErrInvalidPRStatus, err)
+	})
+}
+
+func TestPRService_Merge(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful merge", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockTeams := &mocks.MockTeamRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		existingPR := models.PullRequest{
+			ID:       "pr-1",
+			Name:     "Test PR",
+			AuthorID: "author-1",
+			Status:   models.PRStatusOpen,
+			Reviews: []models.Review{
+				{ReviewerID: "reviewer-1", Decision: models.ReviewDecisionApproved},
+			},
+		}
+
+		mergedPR := existingPR
+		mergedPR.Status = models.PRStatusMerged
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("Teams").Return(mockTeams)
+
+		mockPR.On("GetByID", ctx, "pr-1").Return(existingPR, nil)
+		mockUsers.On("GetByID", ctx, "author-1").Return(models.User{ID: "author-1", TeamName: "backend"}, nil)
+		mockTeams.On("GetSettings", ctx, "backend").Return(models.TeamSettings{
+			ReviewStrategy:    models.ReviewStrategyLeastLoaded,
+			ReviewersCount:    2,
+			RequiredApprovals: 1,
+		}, nil)
+		mockPR.On("Merge", ctx, "pr-1", false).Return(mergedPR, nil)
+		mockEvents := &mocks.MockEventRepository{}
+		mockUOW.On("Events").Return(mockEvents)
+		mockEvents.On("Append", ctx, []models.AssignmentEvent{
+			{Type: models.EventPRMerged, PullRequestID: "pr-1"},
+		}).Return(nil)
+		expectOutbox(ctx, mockUOW, models.TopicPRMerged)
+
+		result, err := service.Merge(ctx, "pr-1", false)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.PRStatusMerged, result.Status)
+		mockUOW.AssertExpectations(t)
+		mockPR.AssertExpectations(t)
+	})
+
+	t.Run("merge rules not met", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockTeams := &mocks.MockTeamRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		existingPR := models.PullRequest{
+			ID:       "pr-1",
+			Name:     "Test PR",
+			AuthorID: "author-1",
+			Status:   models.PRStatusOpen,
+			Reviews: []models.Review{
+				{ReviewerID: "reviewer-1", Decision: models.ReviewDecisionApproved},
+				{ReviewerID: "reviewer-2", Decision: models.ReviewDecisionChangesRequested},
+			},
+		}
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Rollback").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("Teams").Return(mockTeams)
+
+		mockPR.On("GetByID", ctx, "pr-1").Return(existingPR, nil)
+		mockUsers.On("GetByID", ctx, "author-1").Return(models.User{ID: "author-1", TeamName: "backend"}, nil)
+		mockTeams.On("GetSettings", ctx, "backend").Return(models.TeamSettings{
+			ReviewStrategy:          models.ReviewStrategyLeastLoaded,
+			ReviewersCount:          2,
+			RequiredApprovals:       1,
+			BlockOnChangesRequested: true,
+		}, nil)
+
+		_, err := service.Merge(ctx, "pr-1", false)
+
+		assert.Equal(t, models.ErrMergeRequirementsNotMet, err)
+		mockPR.AssertNotCalled(t, "Merge", ctx, "pr-1", false)
+		mockUOW.AssertExpectations(t)
+	})
+
+	t.Run("force merge skips rules", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		existingPR := models.PullRequest{
+			ID:       "pr-1",
+			Name:     "Test PR",
+			AuthorID: "author-1",
+			Status:   models.PRStatusOpen,
+		}
+
+		mergedPR := existingPR
+		mergedPR.Status = models.PRStatusMerged
+		mergedPR.ForceMerged = true
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+
+		mockPR.On("GetByID", ctx, "pr-1").Return(existingPR, nil)
+		mockPR.On("Merge", ctx, "pr-1", true).Return(mergedPR, nil)
+		mockEvents := &mocks.MockEventRepository{}
+		mockUOW.On("Events").Return(mockEvents)
+		mockEvents.On("Append", ctx, []models.AssignmentEvent{
+			{Type: models.EventPRMerged, PullRequestID: "pr-1", Reason: models.ReasonForceMerged},
+		}).Return(nil)
+		expectOutbox(ctx, mockUOW, models.TopicPRMerged)
+
+		result, err := service.Merge(ctx, "pr-1", true)
+
+		require.NoError(t, err)
+		assert.True(t, result.ForceMerged)
+		mockUOW.AssertExpectations(t)
+		mockPR.AssertExpectations(t)
+	})
+
+	t.Run("pr not found", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Rollback").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+
+		mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{}, models.ErrPullRequestNotFound)
+
+		result, err := service.Merge(ctx, "pr-1", false)
+
+		assert.Error(t, err)
+		assert.Equal(t, models.ErrPullRequestNotFound, err)
+		assert.Equal(t, models.PullRequest{}, result)
+		mockUOW.AssertExpectations(t)
+	})
+
+	t.Run("PR already merged", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mergedPR := models.PullRequest{
+			ID:     "pr-1",
+			Name:   "Test PR",
+			Status: models.PRStatusMerged,
+		}
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Rollback").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+
+		mockPR.On("GetByID", ctx, "pr-1").Return(mergedPR, nil)
+
+		result, err := service.Merge(ctx, "pr-1", false)
+
+		assert.Error(t, err)
+		assert.Equal(t, models.ErrPullRequestAlreadyMerged, err)
+		assert.Equal(t, mergedPR, result)
+		mockUOW.AssertExpectations(t)
+	})
 }
 
-func TestPRService_Merge(t *testing.T) {
-	ctx := context.Background()
+func TestPRService_ReassignReviewer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful reassignment", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockTeams := &mocks.MockTeamRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		pr := models.PullRequest{
+			ID:                "pr-1",
+			Name:              "Test PR",
+			AuthorID:          "author-1",
+			Status:            models.PRStatusOpen,
+			AssignedReviewers: []string{"old-reviewer-1", "reviewer-2"},
+		}
+
+		reviewers := []models.User{
+			{ID: "old-reviewer-1", Username: "Old Reviewer", IsActive: true},
+			{ID: "reviewer-2", Username: "Reviewer 2", IsActive: true},
+		}
+
+		teammates := []models.User{
+			{ID: "new-reviewer-1", Username: "New Reviewer 1", IsActive: true},
+			{ID: "new-reviewer-2", Username: "New Reviewer 2", IsActive: true},
+		}
+
+		updatedPR := pr
+		updatedPR.AssignedReviewers = []string{"new-reviewer-1", "reviewer-2"}
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("Teams").Return(mockTeams)
+
+		mockPR.On("GetByID", ctx, "pr-1").Return(pr, nil)
+		mockUsers.On("GetByID", ctx, "old-reviewer-1").Return(models.User{ID: "old-reviewer-1", TeamName: "backend"}, nil)
+		mockPR.On("GetReviewers", ctx, "pr-1").Return(reviewers, nil)
+		mockUsers.On("GetActiveTeammatesByUserID", ctx, "old-reviewer-1").Return(teammates, nil)
+		mockTeams.On("GetSettings", ctx, "backend").Return(models.DefaultTeamSettings(), nil)
+		mockPR.On("CountOpenReviews", ctx, []string{"new-reviewer-1", "new-reviewer-2"}).
+			Return(map[string]int{"new-reviewer-1": 1, "new-reviewer-2": 4}, nil)
+		mockPR.On("Reassign", ctx, "pr-1", "old-reviewer-1", "new-reviewer-1").Return(updatedPR, nil)
+		mockEvents := &mocks.MockEventRepository{}
+		mockUOW.On("Events").Return(mockEvents)
+		mockEvents.On("Append", ctx, []models.AssignmentEvent{{
+			Type:          models.EventReviewerReassigned,
+			PullRequestID: "pr-1",
+			UserID:        "new-reviewer-1",
+			OldUserID:     "old-reviewer-1",
+			Reason:        "on vacation",
+			ActorID:       "author-1",
+		}}).Return(nil)
+		expectOutbox(ctx, mockUOW, models.TopicReviewerReassigned)
+
+		opts := models.ReassignOptions{ActorID: "author-1", Reason: "on vacation"}
+		result, newReviewerID, err := service.ReassignReviewer(ctx, "pr-1", "old-reviewer-1", opts)
+
+		require.NoError(t, err)
+		assert.Equal(t, updatedPR, result)
+		assert.Equal(t, "new-reviewer-1", newReviewerID)
+		mockUOW.AssertExpectations(t)
+		mockEvents.AssertExpectations(t)
+	})
+
+	t.Run("replacement within working hours is preferred", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockTeams := &mocks.MockTeamRepository{}
+		mockEvents := &mocks.MockEventRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+		// 08:00 in New York and 15:00 in Moscow
+		service.now = func() time.Time { return time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC) }
+
+		pr := models.PullRequest{
+			ID:                "pr-1",
+			AuthorID:          "author-1",
+			Status:            models.PRStatusOpen,
+			AssignedReviewers: []string{"old-reviewer-1"},
+		}
+		dayShift := &models.WorkingHours{Start: "09:00", End: "18:00"}
+		teammates := []models.User{
+			{ID: "new-reviewer-1", IsActive: true, Timezone: "America/New_York", WorkingHours: dayShift},
+			{ID: "new-reviewer-2", IsActive: true, Timezone: "Europe/Moscow", WorkingHours: dayShift},
+		}
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockUOW.On("Events").Return(mockEvents)
+
+		mockPR.On("GetByID", ctx, "pr-1").Return(pr, nil)
+		mockUsers.On("GetByID", ctx, "old-reviewer-1").Return(models.User{ID: "old-reviewer-1", TeamName: "backend"}, nil)
+		mockPR.On("GetReviewers", ctx, "pr-1").Return([]models.User{{ID: "old-reviewer-1", IsActive: true}}, nil)
+		mockUsers.On("GetActiveTeammatesByUserID", ctx, "old-reviewer-1").Return(teammates, nil)
+		mockTeams.On("GetSettings", ctx, "backend").Return(models.DefaultTeamSettings(), nil)
+		// least loaded alone would pick new-reviewer-1
+		mockPR.On("CountOpenReviews", ctx, []string{"new-reviewer-2"}).
+			Return(map[string]int{"new-reviewer-2": 4}, nil)
+		mockPR.On("Reassign", ctx, "pr-1", "old-reviewer-1", "new-reviewer-2").Return(pr, nil)
+		mockEvents.On("Append", ctx, mock.Anything).Return(nil)
+		expectOutbox(ctx, mockUOW, models.TopicReviewerReassigned)
+
+		_, newReviewerID, err := service.ReassignReviewer(ctx, "pr-1", "old-reviewer-1", models.ReassignOptions{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "new-reviewer-2", newReviewerID)
+		mockPR.AssertExpectations(t)
+	})
+
+	t.Run("user is not a reviewer", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+		mockUsers := &mocks.MockUserRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		pr := models.PullRequest{
+			ID:                "pr-1",
+			Name:              "Test PR",
+			AuthorID:          "author-1",
+			Status:            models.PRStatusOpen,
+			AssignedReviewers: []string{"reviewer-1", "reviewer-2"},
+		}
+
+		reviewers := []models.User{
+			{ID: "reviewer-1", Username: "Reviewer 1", IsActive: true},
+			{ID: "reviewer-2", Username: "Reviewer 2", IsActive: true},
+		}
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Rollback").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Users").Return(mockUsers)
+
+		mockPR.On("GetByID", ctx, "pr-1").Return(pr, nil)
+		mockUsers.On("GetByID", ctx, "not-a-reviewer").Return(models.User{ID: "not-a-reviewer"}, nil)
+		mockPR.On("GetReviewers", ctx, "pr-1").Return(reviewers, nil)
+
+		result, newReviewerID, err := service.ReassignReviewer(ctx, "pr-1", "not-a-reviewer", models.ReassignOptions{})
+
+		assert.Error(t, err)
+		assert.Equal(t, models.ErrUserNotReviewer, err)
+		assert.Equal(t, models.PullRequest{}, result)
+		assert.Equal(t, "", newReviewerID)
+	})
+
+	t.Run("chosen reviewer", func(t *testing.T) {
+		pr := models.PullRequest{
+			ID:                "pr-1",
+			AuthorID:          "author-1",
+			Status:            models.PRStatusOpen,
+			AssignedReviewers: []string{"reviewer-1", "reviewer-2"},
+		}
+		teammates := []models.User{
+			{ID: "author-1", IsActive: true},
+			{ID: "reviewer-2", IsActive: true},
+			{ID: "reviewer-3", IsActive: true},
+			{ID: "reviewer-4", IsActive: true},
+		}
+
+		tests := []struct {
+			name          string
+			newReviewerID string
+			expected      error
+		}{
+			{"teammate", "reviewer-4", nil},
+			{"author", "author-1", models.ErrNotReassignCandidate},
+			{"already a reviewer", "reviewer-2", models.ErrNotReassignCandidate},
+			{"not a teammate", "stranger", models.ErrNotReassignCandidate},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockUOW := &mocks.MockUnitOfWork{}
+				mockPR := &mocks.MockPRRepository{}
+				mockUsers := &mocks.MockUserRepository{}
+				mockTeams := &mocks.MockTeamRepository{}
+				mockEvents := &mocks.MockEventRepository{}
+
+				service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+					return mockUOW, nil
+				})
+
+				mockUOW.On("Begin", ctx).Return(nil)
+				mockUOW.On("Commit").Return(nil)
+				mockUOW.On("Rollback").Return(nil)
+				mockUOW.On("Close").Return(nil)
+				mockUOW.On("PR").Return(mockPR)
+				mockUOW.On("Users").Return(mockUsers)
+				mockUOW.On("Teams").Return(mockTeams)
+				mockUOW.On("Events").Return(mockEvents)
+
+				mockPR.On("GetByID", ctx, "pr-1").Return(pr, nil)
+				mockUsers.On("GetByID", ctx, "reviewer-1").Return(models.User{ID: "reviewer-1", TeamName: "backend"}, nil)
+				mockPR.On("GetReviewers", ctx, "pr-1").Return([]models.User{{ID: "reviewer-1"}, {ID: "reviewer-2"}}, nil)
+				mockUsers.On("GetActiveTeammatesByUserID", ctx, "reviewer-1").Return(teammates, nil)
+				mockTeams.On("GetSettings", ctx, "backend").Return(models.DefaultTeamSettings(), nil)
+				mockPR.On("Reassign", ctx, "pr-1", "reviewer-1", "reviewer-4").Return(pr, nil)
+				mockEvents.On("Append", ctx, mock.Anything).Return(nil)
+				expectOutbox(ctx, mockUOW, models.TopicReviewerReassigned)
+
+				_, newReviewerID, err := service.ReassignReviewer(ctx, "pr-1", "reviewer-1", models.ReassignOptions{
+					NewReviewerID: tt.newReviewerID,
+				})
+
+				assert.Equal(t, tt.expected, err)
+				if tt.expected == nil {
+					assert.Equal(t, tt.newReviewerID, newReviewerID)
+				} else {
+					mockPR.AssertNotCalled(t, "Reassign", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				}
+				// strategy is not used when the reviewer is chosen
+				mockPR.AssertNotCalled(t, "CountOpenReviews", mock.Anything, mock.Anything)
+			})
+		}
+	})
+
+	t.Run("teammates at capacity", func(t *testing.T) {
+		limit := 1
+		pr := models.PullRequest{
+			ID:                "pr-1",
+			AuthorID:          "author-1",
+			Status:            models.PRStatusOpen,
+			AssignedReviewers: []string{"reviewer-1"},
+		}
+		teammates := []models.User{
+			{ID: "reviewer-2", IsActive: true, MaxOpenReviews: &limit, OpenReviews: 1},
+		}
 
-	t.Run("successful merge", func(t *testing.T) {
+		tests := []struct {
+			policy   models.OverflowPolicy
+			expected error
+		}{
+			{models.OverflowAssignAnyway, nil},
+			{models.OverflowAssignFewer, models.ErrNoCandidateToReassign},
+			{models.OverflowReject, models.ErrReviewersAtCapacity},
+		}
+
+		for _, tt := range tests {
+			t.Run(string(tt.policy), func(t *testing.T) {
+				mockUOW := &mocks.MockUnitOfWork{}
+				mockPR := &mocks.MockPRRepository{}
+				mockUsers := &mocks.MockUserRepository{}
+				mockTeams := &mocks.MockTeamRepository{}
+				mockEvents := &mocks.MockEventRepository{}
+
+				service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+					return mockUOW, nil
+				})
+
+				mockUOW.On("Begin", ctx).Return(nil)
+				mockUOW.On("Commit").Return(nil)
+				mockUOW.On("Rollback").Return(nil)
+				mockUOW.On("Close").Return(nil)
+				mockUOW.On("PR").Return(mockPR)
+				mockUOW.On("Users").Return(mockUsers)
+				mockUOW.On("Teams").Return(mockTeams)
+				mockUOW.On("Events").Return(mockEvents)
+
+				mockPR.On("GetByID", ctx, "pr-1").Return(pr, nil)
+				mockUsers.On("GetByID", ctx, "reviewer-1").Return(models.User{ID: "reviewer-1", TeamName: "backend"}, nil)
+				mockPR.On("GetReviewers", ctx, "pr-1").Return([]models.User{{ID: "reviewer-1"}}, nil)
+				mockUsers.On("GetActiveTeammatesByUserID", ctx, "reviewer-1").Return(teammates, nil)
+				mockTeams.On("GetSettings", ctx, "backend").Return(models.TeamSettings{
+					ReviewStrategy: models.ReviewStrategyRandom,
+					ReviewersCount: 1,
+					OverflowPolicy: tt.policy,
+				}, nil)
+				mockPR.On("Reassign", ctx, "pr-1", "reviewer-1", "reviewer-2").Return(pr, nil)
+				mockEvents.On("Append", ctx, mock.Anything).Return(nil)
+				expectOutbox(ctx, mockUOW, models.TopicReviewerReassigned)
+
+				_, newReviewerID, err := service.ReassignReviewer(ctx, "pr-1", "reviewer-1", models.ReassignOptions{})
+
+				assert.Equal(t, tt.expected, err)
+				if tt.expected == nil {
+					assert.Equal(t, "reviewer-2", newReviewerID)
+				}
+			})
+		}
+	})
+
+	t.Run("requested reviewer is not replaced without force", func(t *testing.T) {
 		mockUOW := &mocks.MockUnitOfWork{}
 		mockPR := &mocks.MockPRRepository{}
+		mockUsers := &mocks.MockUserRepository{}
 
 		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
 			return mockUOW, nil
 		})
 
-		existingPR := models.PullRequest{
-			ID:     "pr-1",
-			Name:   "Test PR",
-			Status: models.PRStatusOpen,
+		pr := models.PullRequest{
+			ID:                 "pr-1",
+			AuthorID:           "author-1",
+			Status:             models.PRStatusOpen,
+			AssignedReviewers:  []string{"reviewer-1", "reviewer-2"},
+			RequestedReviewers: []string{"reviewer-1"},
 		}
 
-		mergedPR := existingPR
-		mergedPR.Status = models.PRStatusMerged
-
 		mockUOW.On("Begin", ctx).Return(nil)
-		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Rollback").Return(nil)
 		mockUOW.On("Close").Return(nil)
 		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Users").Return(mockUsers)
 
-		mockPR.On("GetByID", ctx, "pr-1").Return(existingPR, nil)
-		mockPR.On("Merge", ctx, "pr-1").Return(mergedPR, nil)
+		mockPR.On("GetByID", ctx, "pr-1").Return(pr, nil)
+		mockUsers.On("GetByID", ctx, "reviewer-1").Return(models.User{ID: "reviewer-1", TeamName: "backend"}, nil)
+		mockPR.On("GetReviewers", ctx, "pr-1").Return([]models.User{{ID: "reviewer-1"}, {ID: "reviewer-2"}}, nil)
 
-		result, err := service.Merge(ctx, "pr-1")
+		_, _, err := service.ReassignReviewer(ctx, "pr-1", "reviewer-1", models.ReassignOptions{})
 
-		require.NoError(t, err)
-		assert.Equal(t, models.PRStatusMerged, result.Status)
-		mockUOW.AssertExpectations(t)
+		assert.Equal(t, models.ErrReviewerRequested, err)
+		mockPR.AssertNotCalled(t, "Reassign", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	})
 
-	t.Run("pr not found", func(t *testing.T) {
+	t.Run("requested reviewer is replaced with force", func(t *testing.T) {
 		mockUOW := &mocks.MockUnitOfWork{}
 		mockPR := &mocks.MockPRRepository{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockTeams := &mocks.MockTeamRepository{}
+		mockEvents := &mocks.MockEventRepository{}
 
 		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
 			return mockUOW, nil
 		})
 
+		pr := models.PullRequest{
+			ID:                 "pr-1",
+			AuthorID:           "author-1",
+			Status:             models.PRStatusOpen,
+			AssignedReviewers:  []string{"reviewer-1", "reviewer-2"},
+			RequestedReviewers: []string{"reviewer-1"},
+		}
+		updatedPR := pr
+		updatedPR.AssignedReviewers = []string{"reviewer-3", "reviewer-2"}
+		updatedPR.RequestedReviewers = nil
+
 		mockUOW.On("Begin", ctx).Return(nil)
-		mockUOW.On("Rollback").Return(nil)
+		mockUOW.On("Commit").Return(nil)
 		mockUOW.On("Close").Return(nil)
 		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockUOW.On("Events").Return(mockEvents)
 
-		mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{}, models.ErrPullRequestNotFound)
-
-		result, err := service.Merge(ctx, "pr-1")
+		mockPR.On("GetByID", ctx, "pr-1").Return(pr, nil)
+		mockUsers.On("GetByID", ctx, "reviewer-1").Return(models.User{ID: "reviewer-1", TeamName: "backend"}, nil)
+		mockPR.On("GetReviewers", ctx, "pr-1").Return([]models.User{{ID: "reviewer-1"}, {ID: "reviewer-2"}}, nil)
+		mockUsers.On("GetActiveTeammatesByUserID", ctx, "reviewer-1").
+			Return([]models.User{{ID: "reviewer-3", IsActive: true}}, nil)
+		mockTeams.On("GetSettings", ctx, "backend").Return(models.DefaultTeamSettings(), nil)
+		mockPR.On("CountOpenReviews", ctx, []string{"reviewer-3"}).Return(map[string]int{}, nil)
+		mockPR.On("Reassign", ctx, "pr-1", "reviewer-1", "reviewer-3").Return(updatedPR, nil)
+		mockEvents.On("Append", ctx, mock.Anything).Return(nil)
+		expectOutbox(ctx, mockUOW, models.TopicReviewerReassigned)
+
+		result, newReviewerID, err := service.ReassignReviewer(ctx, "pr-1", "reviewer-1", models.ReassignOptions{Force: true})
 
-		assert.Error(t, err)
-		assert.Equal(t, models.ErrPullRequestNotFound, err)
-		assert.Equal(t, models.PullRequest{}, result)
-		mockUOW.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Equal(t, "reviewer-3", newReviewerID)
+		assert.Empty(t, result.RequestedReviewers)
+		mockPR.AssertExpectations(t)
 	})
+}
 
-	t.Run("PR already merged", func(t *testing.T) {
+func TestPRService_AddReviewer(t *testing.T) {
+	ctx := context.Background()
+
+	openPR := models.PullRequest{
+		ID:                "pr-1",
+		AuthorID:          "author-1",
+		Status:            models.PRStatusOpen,
+		AssignedReviewers: []string{"reviewer-1"},
+	}
+	author := models.User{ID: "author-1", TeamName: "backend", IsActive: true}
+
+	t.Run("teammate is added", func(t *testing.T) {
 		mockUOW := &mocks.MockUnitOfWork{}
 		mockPR := &mocks.MockPRRepository{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockTeams := &mocks.MockTeamRepository{}
+		mockEvents := &mocks.MockEventRepository{}
 
 		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
 			return mockUOW, nil
 		})
 
-		mergedPR := models.PullRequest{
-			ID:     "pr-1",
-			Name:   "Test PR",
-			Status: models.PRStatusMerged,
-		}
+		updatedPR := openPR
+		updatedPR.AssignedReviewers = []string{"reviewer-1", "reviewer-2"}
 
 		mockUOW.On("Begin", ctx).Return(nil)
-		mockUOW.On("Rollback").Return(nil)
+		mockUOW.On("Commit").Return(nil)
 		mockUOW.On("Close").Return(nil)
 		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockUOW.On("Events").Return(mockEvents)
+
+		mockPR.On("GetByID", ctx, "pr-1").Return(openPR, nil)
+		mockTeams.On("GetSettings", ctx, "backend").Return(models.DefaultTeamSettings(), nil)
+		mockUsers.On("GetByID", ctx, "reviewer-2").
+			Return(models.User{ID: "reviewer-2", TeamName: "backend", IsActive: true}, nil)
+		mockUsers.On("GetByID", ctx, "author-1").Return(author, nil)
+		mockPR.On("AddReviewer", ctx, "pr-1", "reviewer-2").Return(updatedPR, nil)
+		mockEvents.On("Append", ctx, []models.AssignmentEvent{{
+			Type:          models.EventReviewerAssigned,
+			PullRequestID: "pr-1",
+			UserID:        "reviewer-2",
+			ActorID:       "author-1",
+		}}).Return(nil)
+		expectOutbox(ctx, mockUOW, models.TopicReviewerAssigned)
+
+		result, err := service.AddReviewer(ctx, "pr-1", "reviewer-2", "author-1")
 
-		mockPR.On("GetByID", ctx, "pr-1").Return(mergedPR, nil)
+		require.NoError(t, err)
+		assert.Equal(t, updatedPR, result)
+		mockPR.AssertExpectations(t)
+		mockEvents.AssertExpectations(t)
+	})
 
-		result, err := service.Merge(ctx, "pr-1")
+	t.Run("member of partner team is added", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockTeams := &mocks.MockTeamRepository{}
+		mockEvents := &mocks.MockEventRepository{}
 
-		assert.Error(t, err)
-		assert.Equal(t, models.ErrPullRequestAlreadyMerged, err)
-		assert.Equal(t, mergedPR, result)
-		mockUOW.AssertExpectations(t)
-	})
-}
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
 
-func TestPRService_ReassignReviewer(t *testing.T) {
-	ctx := context.Background()
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockUOW.On("Events").Return(mockEvents)
+
+		mockPR.On("GetByID", ctx, "pr-1").Return(openPR, nil)
+		mockUsers.On("GetByID", ctx, "mobile-1").
+			Return(models.User{ID: "mobile-1", TeamName: "mobile", IsActive: true}, nil)
+		mockUsers.On("GetByID", ctx, "author-1").Return(author, nil)
+		mockTeams.On("GetSettings", ctx, "backend").Return(models.DefaultTeamSettings(), nil)
+		mockTeams.On("GetByName", ctx, "backend").
+			Return(models.Team{Name: "backend", PartnerTeams: []string{"mobile"}}, nil)
+		mockPR.On("AddReviewer", ctx, "pr-1", "mobile-1").Return(openPR, nil)
+		mockEvents.On("Append", ctx, mock.Anything).Return(nil)
+		expectOutbox(ctx, mockUOW, models.TopicReviewerAssigned)
+
+		_, err := service.AddReviewer(ctx, "pr-1", "mobile-1", "")
 
-	t.Run("successful reassignment", func(t *testing.T) {
+		require.NoError(t, err)
+		mockPR.AssertExpectations(t)
+	})
+
+	t.Run("user of other team is rejected", func(t *testing.T) {
 		mockUOW := &mocks.MockUnitOfWork{}
 		mockPR := &mocks.MockPRRepository{}
 		mockUsers := &mocks.MockUserRepository{}
+		mockTeams := &mocks.MockTeamRepository{}
 
 		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
 			return mockUOW, nil
 		})
 
-		pr := models.PullRequest{
-			ID:                "pr-1",
-			Name:              "Test PR",
-			AuthorID:          "author-1",
-			Status:            models.PRStatusOpen,
-			AssignedReviewers: []string{"old-reviewer-1", "reviewer-2"},
-		}
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Rollback").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("Teams").Return(mockTeams)
 
-		reviewers := []models.User{
-			{ID: "old-reviewer-1", Username: "Old Reviewer", IsActive: true},
-			{ID: "reviewer-2", Username: "Reviewer 2", IsActive: true},
+		mockPR.On("GetByID", ctx, "pr-1").Return(openPR, nil)
+		mockUsers.On("GetByID", ctx, "frontend-1").
+			Return(models.User{ID: "frontend-1", TeamName: "frontend", IsActive: true}, nil)
+		mockUsers.On("GetByID", ctx, "author-1").Return(author, nil)
+		mockTeams.On("GetSettings", ctx, "backend").Return(models.DefaultTeamSettings(), nil)
+		mockTeams.On("GetByName", ctx, "backend").Return(models.Team{Name: "backend"}, nil)
+
+		_, err := service.AddReviewer(ctx, "pr-1", "frontend-1", "")
+
+		assert.Equal(t, models.ErrReviewerNotInTeam, err)
+		mockPR.AssertNotCalled(t, "AddReviewer", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		// default team settings require 2 reviewers
+		fullPR := openPR
+		fullPR.AssignedReviewers = []string{"reviewer-1", "reviewer-3"}
+		mergedPR := openPR
+		mergedPR.Status = models.PRStatusMerged
+		closedPR := openPR
+		closedPR.Status = models.PRStatusClosed
+		requestedDraft := openPR
+		requestedDraft.Status = models.PRStatusDraft
+		requestedDraft.RequestedReviewers = []string{"reviewer-1", "reviewer-3"}
+
+		tests := []struct {
+			name       string
+			pr         models.PullRequest
+			reviewerID string
+			reviewer   models.User
+			expected   error
+		}{
+			{"merged", mergedPR, "reviewer-2", models.User{}, models.ErrPullRequestAlreadyMerged},
+			{"closed", closedPR, "reviewer-2", models.User{}, models.ErrPullRequestNotOpen},
+			{"author", openPR, "author-1", models.User{}, models.ErrReviewerIsAuthor},
+			{"already assigned", openPR, "reviewer-1", models.User{}, models.ErrReviewerAlreadyAssigned},
+			{"too many", fullPR, "reviewer-2", models.User{}, models.ErrTooManyReviewers},
+			{"too many with draft requests", requestedDraft, "reviewer-2", models.User{}, models.ErrTooManyReviewers},
+			{"inactive", openPR, "reviewer-2", models.User{ID: "reviewer-2", TeamName: "backend"}, models.ErrReviewerInactive},
 		}
 
-		teammates := []models.User{
-			{ID: "new-reviewer-1", Username: "New Reviewer 1", IsActive: true},
-			{ID: "new-reviewer-2", Username: "New Reviewer 2", IsActive: true},
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockUOW := &mocks.MockUnitOfWork{}
+				mockPR := &mocks.MockPRRepository{}
+				mockUsers := &mocks.MockUserRepository{}
+				mockTeams := &mocks.MockTeamRepository{}
+
+				service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+					return mockUOW, nil
+				})
+
+				mockUOW.On("Begin", ctx).Return(nil)
+				mockUOW.On("Rollback").Return(nil)
+				mockUOW.On("Close").Return(nil)
+				mockUOW.On("PR").Return(mockPR)
+				mockUOW.On("Users").Return(mockUsers)
+				mockUOW.On("Teams").Return(mockTeams)
+
+				mockPR.On("GetByID", ctx, "pr-1").Return(tt.pr, nil)
+				mockUsers.On("GetByID", ctx, "author-1").Return(author, nil)
+				mockTeams.On("GetSettings", ctx, "backend").Return(models.DefaultTeamSettings(), nil)
+				mockUsers.On("GetByID", ctx, tt.reviewerID).Return(tt.reviewer, nil)
+
+				_, err := service.AddReviewer(ctx, "pr-1", tt.reviewerID, "")
+
+				assert.Equal(t, tt.expected, err)
+				mockPR.AssertNotCalled(t, "AddReviewer", mock.Anything, mock.Anything, mock.Anything)
+			})
 		}
+	})
+}
+
+func TestPRService_RemoveReviewer(t *testing.T) {
+	ctx := context.Background()
+
+	pr := models.PullRequest{
+		ID:                "pr-1",
+		AuthorID:          "author-1",
+		Status:            models.PRStatusOpen,
+		AssignedReviewers: []string{"reviewer-1", "reviewer-2"},
+	}
+
+	t.Run("reviewer is removed", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+		mockEvents := &mocks.MockEventRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
 
 		updatedPR := pr
-		updatedPR.AssignedReviewers = []string{"new-reviewer-1", "reviewer-2"}
+		updatedPR.AssignedReviewers = []string{"reviewer-2"}
 
 		mockUOW.On("Begin", ctx).Return(nil)
 		mockUOW.On("Commit").Return(nil)
 		mockUOW.On("Close").Return(nil)
 		mockUOW.On("PR").Return(mockPR)
-		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("Events").Return(mockEvents)
 
 		mockPR.On("GetByID", ctx, "pr-1").Return(pr, nil)
-		mockUsers.On("GetByID", ctx, "old-reviewer-1").Return(models.User{ID: "old-reviewer-1"}, nil)
-		mockPR.On("GetReviewers", ctx, "pr-1").Return(reviewers, nil)
-		mockUsers.On("GetActiveTeammatesByUserID", ctx, "old-reviewer-1").Return(teammates, nil)
-		mockPR.On("Reassign", ctx, "pr-1", "old-reviewer-1", mock.AnythingOfType("string")).Return(updatedPR, nil)
+		mockPR.On("RemoveReviewer", ctx, "pr-1", "reviewer-1").Return(updatedPR, nil)
+		mockEvents.On("Append", ctx, []models.AssignmentEvent{{
+			Type:          models.EventReviewerRemoved,
+			PullRequestID: "pr-1",
+			UserID:        "reviewer-1",
+			ActorID:       "author-1",
+		}}).Return(nil)
+		mockOutbox := expectOutbox(ctx, mockUOW, models.TopicReviewerRemoved)
 
-		result, newReviewerID, err := service.ReassignReviewer(ctx, "pr-1", "old-reviewer-1")
+		result, err := service.RemoveReviewer(ctx, "pr-1", "reviewer-1", "author-1")
 
 		require.NoError(t, err)
 		assert.Equal(t, updatedPR, result)
-		assert.Contains(t, []string{"new-reviewer-1", "new-reviewer-2"}, newReviewerID)
-		mockUOW.AssertExpectations(t)
+		mockEvents.AssertExpectations(t)
+		mockOutbox.AssertExpectations(t)
 	})
 
 	t.Run("user is not a reviewer", func(t *testing.T) {
 		mockUOW := &mocks.MockUnitOfWork{}
 		mockPR := &mocks.MockPRRepository{}
-		mockUsers := &mocks.MockUserRepository{}
 
 		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
 			return mockUOW, nil
 		})
 
-		pr := models.PullRequest{
-			ID:                "pr-1",
-			Name:              "Test PR",
-			AuthorID:          "author-1",
-			Status:            models.PRStatusOpen,
-			AssignedReviewers: []string{"reviewer-1", "reviewer-2"},
-		}
-
-		reviewers := []models.User{
-			{ID: "reviewer-1", Username: "Reviewer 1", IsActive: true},
-			{ID: "reviewer-2", Username: "Reviewer 2", IsActive: true},
-		}
-
 		mockUOW.On("Begin", ctx).Return(nil)
 		mockUOW.On("Rollback").Return(nil)
 		mockUOW.On("Close").Return(nil)
 		mockUOW.On("PR").Return(mockPR)
-		mockUOW.On("Users").Return(mockUsers)
 
 		mockPR.On("GetByID", ctx, "pr-1").Return(pr, nil)
-		mockUsers.On("GetByID", ctx, "not-a-reviewer").Return(models.User{ID: "not-a-reviewer"}, nil)
-		mockPR.On("GetReviewers", ctx, "pr-1").Return(reviewers, nil)
+		mockPR.On("RemoveReviewer", ctx, "pr-1", "user-9").Return(models.PullRequest{}, models.ErrUserNotReviewer)
 
-		result, newReviewerID, err := service.ReassignReviewer(ctx, "pr-1", "not-a-reviewer")
+		_, err := service.RemoveReviewer(ctx, "pr-1", "user-9", "")
 
-		assert.Error(t, err)
 		assert.Equal(t, models.ErrUserNotReviewer, err)
-		assert.Equal(t, models.PullRequest{}, result)
-		assert.Equal(t, "", newReviewerID)
 	})
-}
 
-func TestPRService_selectRandomReviewers(t *testing.T) {
-	service := &PRService{}
+	t.Run("merged PR is not changed", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
 
-	t.Run("empty users", func(t *testing.T) {
-		users := []models.User{}
-		result := service.selectRandomReviewers(users, 2)
-		assert.Empty(t, result)
-	})
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
 
-	t.Run("users less than max", func(t *testing.T) {
-		users := []models.User{
-			{ID: "user-1", Username: "User 1"},
-			{ID: "user-2", Username: "User 2"},
-		}
-		result := service.selectRandomReviewers(users, 3)
-		assert.Len(t, result, 2)
-	})
+		mergedPR := pr
+		mergedPR.Status = models.PRStatusMerged
 
-	t.Run("users more than max", func(t *testing.T) {
-		users := []models.User{
-			{ID: "user-1", Username: "User 1"},
-			{ID: "user-2", Username: "User 2"},
-			{ID: "user-3", Username: "User 3"},
-			{ID: "user-4", Username: "User 4"},
-		}
-		result := service.selectRandomReviewers(users, 2)
-		assert.Len(t, result, 2)
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Rollback").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+
+		mockPR.On("GetByID", ctx, "pr-1").Return(mergedPR, nil)
+
+		_, err := service.RemoveReviewer(ctx, "pr-1", "reviewer-1", "")
+
+		assert.Equal(t, models.ErrPullRequestAlreadyMerged, err)
+		mockPR.AssertNotCalled(t, "RemoveReviewer", mock.Anything, mock.Anything, mock.Anything)
 	})
 }
 
-func TestPRService_filterCandidates(t *testing.T) {
-	service := &PRService{}
-
+func TestFilterCandidates(t *testing.T) {
 	pr := models.PullRequest{
 		ID:                "pr-1",
 		AuthorID:          "author-1",
@@ -407,9 +1911,119 @@ func TestPRService_filterCandidates(t *testing.T) {
 		{ID: "candidate-2"},
 	}
 
-	result := service.filterCandidates(candidates, pr, "old-reviewer-1")
+	result := filterCandidates(candidates, pr, "old-reviewer-1")
 
 	assert.Len(t, result, 2)
 	assert.Equal(t, "candidate-1", result[0].ID)
 	assert.Equal(t, "candidate-2", result[1].ID)
 }
+
+func TestPRService_SubmitReview(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful review", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		pr := models.PullRequest{
+			ID:                "pr-1",
+			Status:            models.PRStatusOpen,
+			AssignedReviewers: []string{"reviewer-1"},
+		}
+
+		reviewedPR := pr
+		reviewedPR.Reviews = []models.Review{
+			{ReviewerID: "reviewer-1", Decision: models.ReviewDecisionApproved},
+		}
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+
+		mockPR.On("GetByID", ctx, "pr-1").Return(pr, nil)
+		mockPR.On("SubmitReview", ctx, "pr-1", "reviewer-1", models.ReviewDecisionApproved).Return(reviewedPR, nil)
+
+		result, err := service.SubmitReview(ctx, "pr-1", "reviewer-1", models.ReviewDecisionApproved)
+
+		require.NoError(t, err)
+		assert.Equal(t, reviewedPR, result)
+		mockUOW.AssertExpectations(t)
+	})
+
+	t.Run("user is not a reviewer", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Rollback").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+
+		mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{ID: "pr-1", Status: models.PRStatusOpen}, nil)
+		mockPR.On("SubmitReview", ctx, "pr-1", "stranger", models.ReviewDecisionCommented).
+			Return(models.PullRequest{}, models.ErrUserNotReviewer)
+
+		result, err := service.SubmitReview(ctx, "pr-1", "stranger", models.ReviewDecisionCommented)
+
+		assert.Equal(t, models.ErrUserNotReviewer, err)
+		assert.Equal(t, models.PullRequest{}, result)
+		mockUOW.AssertExpectations(t)
+	})
+
+	t.Run("pr already merged", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockPR := &mocks.MockPRRepository{}
+
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Rollback").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("PR").Return(mockPR)
+
+		mockPR.On("GetByID", ctx, "pr-1").Return(models.PullRequest{ID: "pr-1", Status: models.PRStatusMerged}, nil)
+
+		_, err := service.SubmitReview(ctx, "pr-1", "reviewer-1", models.ReviewDecisionApproved)
+
+		assert.Equal(t, models.ErrPullRequestAlreadyMerged, err)
+		mockUOW.AssertExpectations(t)
+	})
+
+	t.Run("invalid decision", func(t *testing.T) {
+		service := NewPRService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			t.Fatal("uow should not be created for invalid decision")
+			return nil, nil
+		})
+
+		_, err := service.SubmitReview(ctx, "pr-1", "reviewer-1", models.ReviewDecisionPending)
+
+		assert.Equal(t, models.ErrInvalidReviewDecision, err)
+	})
+}
+
+// expectOutbox expects messages of topics to be added to the outbox for webhooks in the given order
+func expectOutbox(ctx context.Context, mockUOW *mocks.MockUnitOfWork, topics ...string) *mocks.MockOutboxRepository {
+	mockOutbox := &mocks.MockOutboxRepository{}
+	mockUOW.On("Outbox").Return(mockOutbox)
+	mockOutbox.On("Add", ctx, mock.MatchedBy(func(messages []models.OutboxMessage) bool {
+		var got []string
+		for _, message := range messages {
+			if message.Consumer == models.ConsumerWebhooks {
+				got = append(got, message.Topic)
+			}
+		}
+		return assert.ObjectsAreEqual(topics, got)
+	})).Return(nil)
+	return mockOutbox
+}
@@ -0,0 +1,276 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/437d5/pr-review-manager/internal/domain/repositories"
+)
+
+// teamSelector returns reviewer selector and settings configured for the team,
+// the selector prefers candidates who are within working hours at now
+func teamSelector(
+	ctx context.Context,
+	uow repositories.UnitOfWork,
+	selectors map[models.ReviewStrategy]ReviewerSelector,
+	teamName string,
+	now time.Time,
+) (ReviewerSelector, models.TeamSettings, error) {
+	settings, err := uow.Teams().GetSettings(ctx, teamName)
+	if err != nil {
+		slog.Error("cannot get team settings", "error", err.Error(), "team", teamName)
+		return nil, models.TeamSettings{}, err
+	}
+
+	selector, ok := selectors[settings.ReviewStrategy]
+	if !ok {
+		slog.Warn("unknown review strategy, falling back to default",
+			"team", teamName,
+			"strategy", settings.ReviewStrategy,
+		)
+		selector = selectors[models.DefaultTeamSettings().ReviewStrategy]
+	}
+
+	return WorkingHoursSelector{Selector: selector, Now: now}, settings, nil
+}
+
+// recordEvents appends events to the audit log and puts the published ones
+// to the outbox for enabled consumers, must be called inside of the transaction that makes the recorded change
+func recordEvents(
+	ctx context.Context,
+	uow repositories.UnitOfWork,
+	now time.Time,
+	consumers []string,
+	events ...models.AssignmentEvent,
+) error {
+	if err := uow.Events().Append(ctx, events); err != nil {
+		slog.Error("cannot record assignment events", "error", err.Error(), "count", len(events))
+		return err
+	}
+
+	messages, err := models.NewOutboxMessages(events, now, consumers)
+	if err != nil {
+		slog.Error("cannot build outbox messages", "error", err.Error())
+		return err
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+
+	if err := uow.Outbox().Add(ctx, messages); err != nil {
+		slog.Error("cannot add outbox messages", "error", err.Error(), "count", len(messages))
+		return err
+	}
+	return nil
+}
+
+// assignedEvents returns REVIEWER_ASSIGNED event for each of reviewers
+func assignedEvents(prID string, reviewers []string) []models.AssignmentEvent {
+	events := make([]models.AssignmentEvent, len(reviewers))
+	for i, reviewerID := range reviewers {
+		events[i] = models.AssignmentEvent{
+			Type:          models.EventReviewerAssigned,
+			PullRequestID: prID,
+			UserID:        reviewerID,
+		}
+	}
+	return events
+}
+
+// reassignReviewer replaces old reviewer on pr with one of teammates picked by selector,
+// or with opts.NewReviewerID if it is one of the candidates, and records the reassignment with options.
+// Teammates at capacity are candidates only if everyone is at capacity and policy allows it.
+// Must be called inside of transaction, pr must have AssignedReviewers filled.
+func reassignReviewer(
+	ctx context.Context,
+	uow repositories.UnitOfWork,
+	selector ReviewerSelector,
+	policy models.OverflowPolicy,
+	pr models.PullRequest,
+	oldReviewerID string,
+	teammates []models.User,
+	opts models.ReassignOptions,
+	now time.Time,
+	consumers []string,
+) (models.PullRequest, string, error) {
+	// exlude old reviewer and author from candidates
+	candidates, full := splitByCapacity(filterCandidates(teammates, pr, oldReviewerID))
+	if len(candidates) == 0 && len(full) > 0 {
+		switch policy {
+		case models.OverflowReject:
+			slog.Warn("all candidates are at capacity", "pr_id", pr.ID, "old_reviewer_id", oldReviewerID)
+			return models.PullRequest{}, "", models.ErrReviewersAtCapacity
+		case models.OverflowAssignFewer:
+			return models.PullRequest{}, "", models.ErrNoCandidateToReassign
+		default:
+			candidates = full
+		}
+	}
+
+	var newReviewer []models.User
+	if opts.NewReviewerID != "" {
+		i := slices.IndexFunc(candidates, func(user models.User) bool { return user.ID == opts.NewReviewerID })
+		if i < 0 {
+			slog.Warn("chosen reviewer is not a candidate", "pr_id", pr.ID, "new_reviewer_id", opts.NewReviewerID)
+			return models.PullRequest{}, "", models.ErrNotReassignCandidate
+		}
+		newReviewer = candidates[i : i+1]
+	} else {
+		var err error
+		newReviewer, err = selector.Select(ctx, uow.PR(), candidates, 1)
+		if err != nil {
+			slog.Error("cannot select reviewer", "error", err.Error(), "pr_id", pr.ID)
+			return models.PullRequest{}, "", err
+		}
+	}
+
+	if len(newReviewer) == 0 {
+		return models.PullRequest{}, "", models.ErrNoCandidateToReassign
+	}
+
+	updatedPR, err := uow.PR().Reassign(ctx, pr.ID, oldReviewerID, newReviewer[0].ID)
+	if err != nil {
+		slog.Error("cannot reassign reviewer", "error", err.Error(), "pr_id", pr.ID, "old_reviewer_id",
+			oldReviewerID, "new_reviewer_id", newReviewer[0].ID)
+		return models.PullRequest{}, "", err
+	}
+
+	err = recordEvents(ctx, uow, now, consumers, models.AssignmentEvent{
+		Type:          models.EventReviewerReassigned,
+		PullRequestID: pr.ID,
+		UserID:        newReviewer[0].ID,
+		OldUserID:     oldReviewerID,
+		Reason:        opts.Reason,
+		ActorID:       opts.ActorID,
+	})
+	if err != nil {
+		return models.PullRequest{}, "", err
+	}
+
+	return updatedPR, newReviewer[0].ID, nil
+}
+
+// splitByCapacity separates users who can take one more review from those at capacity,
+// order of users is kept
+func splitByCapacity(users []models.User) ([]models.User, []models.User) {
+	available := make([]models.User, 0, len(users))
+	var full []models.User
+	for _, user := range users {
+		if user.AtCapacity() {
+			full = append(full, user)
+		} else {
+			available = append(available, user)
+		}
+	}
+	return available, full
+}
+
+// exclude old reviewer and author from candidates
+func filterCandidates(candidates []models.User, pr models.PullRequest, oldReviewerID string) []models.User {
+	badSet := make(map[string]struct{}, len(pr.AssignedReviewers)+1) // 1 is author id
+	badSet[pr.AuthorID] = struct{}{}
+	badSet[oldReviewerID] = struct{}{}
+	for _, reviewer := range pr.AssignedReviewers {
+		badSet[reviewer] = struct{}{}
+	}
+
+	res := make([]models.User, 0, len(candidates))
+	for _, candidate := range candidates {
+		if _, ok := badSet[candidate.ID]; ok {
+			continue
+		} else {
+			res = append(res, candidate)
+		}
+	}
+
+	return res
+}
+
+// planBulkReassignment replaces leaving reviewers on each of prs with the least loaded candidate,
+// load is updated in place as reviews are handed out so that they are spread evenly.
+// Candidates reaching their review limit are skipped, they get reviews over the limit
+// only under OverflowAssignAnyway, otherwise such reviews are left not reassigned.
+func planBulkReassignment(
+	prs []models.PullRequest,
+	leaving map[string]struct{},
+	available []models.User,
+	full []models.User,
+	load map[string]int,
+	policy models.OverflowPolicy,
+) models.ReassignmentReport {
+	report := models.ReassignmentReport{
+		Reassigned:    []models.ReviewReassignment{},
+		NotReassigned: []models.ReviewReassignment{},
+	}
+
+	// shuffle once so that ties are broken at random
+	available = shuffle(available)
+	everyone := append(slices.Clone(available), shuffle(full)...)
+	overflow := policy == models.OverflowAssignAnyway || policy == ""
+
+	for _, pr := range prs {
+		reviewers := make(map[string]struct{}, len(pr.AssignedReviewers))
+		for _, reviewer := range pr.AssignedReviewers {
+			reviewers[reviewer] = struct{}{}
+		}
+
+		for _, oldReviewerID := range pr.AssignedReviewers {
+			if _, ok := leaving[oldReviewerID]; !ok {
+				continue
+			}
+
+			reassignment := models.ReviewReassignment{
+				PullRequestID: pr.ID,
+				OldReviewerID: oldReviewerID,
+			}
+
+			newReviewerID := leastLoadedCandidate(available, pr.AuthorID, reviewers, load, true)
+			if newReviewerID == "" && overflow {
+				newReviewerID = leastLoadedCandidate(everyone, pr.AuthorID, reviewers, load, false)
+			}
+
+			if newReviewerID == "" {
+				report.NotReassigned = append(report.NotReassigned, reassignment)
+				continue
+			}
+
+			reviewers[newReviewerID] = struct{}{}
+			load[newReviewerID]++
+
+			reassignment.NewReviewerID = newReviewerID
+			report.Reassigned = append(report.Reassigned, reassignment)
+		}
+	}
+
+	return report
+}
+
+// leastLoadedCandidate returns id of the least loaded candidate who is neither the author nor a reviewer,
+// with withinLimit candidates whose load reached their review limit are skipped
+func leastLoadedCandidate(
+	candidates []models.User,
+	authorID string,
+	reviewers map[string]struct{},
+	load map[string]int,
+	withinLimit bool,
+) string {
+	newReviewerID := ""
+	for _, candidate := range candidates {
+		if candidate.ID == authorID {
+			continue
+		}
+		if _, ok := reviewers[candidate.ID]; ok {
+			continue
+		}
+		if withinLimit && candidate.MaxOpenReviews != nil && load[candidate.ID] >= *candidate.MaxOpenReviews {
+			continue
+		}
+		if newReviewerID == "" || load[candidate.ID] < load[newReviewerID] {
+			newReviewerID = candidate.ID
+		}
+	}
+	return newReviewerID
+}
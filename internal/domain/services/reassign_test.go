@@ -0,0 +1,109 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanBulkReassignment(t *testing.T) {
+	leaving := map[string]struct{}{
+		"leaving-1": {},
+		"leaving-2": {},
+	}
+
+	candidates := []models.User{
+		{ID: "author-1"},
+		{ID: "stay-1"},
+		{ID: "stay-2"},
+	}
+
+	t.Run("spreads reviews by load", func(t *testing.T) {
+		prs := []models.PullRequest{
+			{ID: "pr-1", AuthorID: "author-1", AssignedReviewers: []string{"leaving-1", "leaving-2"}},
+			{ID: "pr-2", AuthorID: "author-1", AssignedReviewers: []string{"leaving-1", "stay-1"}},
+			{ID: "pr-3", AuthorID: "stay-2", AssignedReviewers: []string{"leaving-2"}},
+		}
+		load := map[string]int{"author-1": 0, "stay-1": 1, "stay-2": 0}
+
+		report := planBulkReassignment(prs, leaving, candidates, nil, load, models.OverflowAssignAnyway)
+
+		assert.Equal(t, []models.ReviewReassignment{
+			{PullRequestID: "pr-1", OldReviewerID: "leaving-1", NewReviewerID: "stay-2"},
+			{PullRequestID: "pr-1", OldReviewerID: "leaving-2", NewReviewerID: "stay-1"},
+			{PullRequestID: "pr-2", OldReviewerID: "leaving-1", NewReviewerID: "stay-2"},
+			{PullRequestID: "pr-3", OldReviewerID: "leaving-2", NewReviewerID: "author-1"},
+		}, report.Reassigned)
+		assert.Empty(t, report.NotReassigned)
+		assert.Equal(t, map[string]int{"author-1": 1, "stay-1": 2, "stay-2": 2}, load)
+	})
+
+	t.Run("no candidates left", func(t *testing.T) {
+		prs := []models.PullRequest{
+			{ID: "pr-1", AuthorID: "author-1", AssignedReviewers: []string{"leaving-1", "stay-1", "stay-2"}},
+		}
+		load := map[string]int{}
+
+		report := planBulkReassignment(prs, leaving, candidates, nil, load, models.OverflowAssignAnyway)
+
+		assert.Empty(t, report.Reassigned)
+		assert.Equal(t, []models.ReviewReassignment{
+			{PullRequestID: "pr-1", OldReviewerID: "leaving-1"},
+		}, report.NotReassigned)
+	})
+
+	t.Run("review limits", func(t *testing.T) {
+		limit := 2
+		available := []models.User{{ID: "stay-1", MaxOpenReviews: &limit, OpenReviews: 1}}
+		full := []models.User{{ID: "stay-2", MaxOpenReviews: &limit, OpenReviews: 2}}
+		prs := []models.PullRequest{
+			{ID: "pr-1", AuthorID: "author-1", AssignedReviewers: []string{"leaving-1"}},
+			{ID: "pr-2", AuthorID: "author-1", AssignedReviewers: []string{"leaving-1"}},
+		}
+
+		tests := []struct {
+			policy        models.OverflowPolicy
+			reassigned    []models.ReviewReassignment
+			notReassigned []models.ReviewReassignment
+		}{
+			{
+				policy: models.OverflowAssignAnyway,
+				reassigned: []models.ReviewReassignment{
+					{PullRequestID: "pr-1", OldReviewerID: "leaving-1", NewReviewerID: "stay-1"},
+					{PullRequestID: "pr-2", OldReviewerID: "leaving-1", NewReviewerID: "stay-1"},
+				},
+				notReassigned: []models.ReviewReassignment{},
+			},
+			{
+				policy: models.OverflowAssignFewer,
+				reassigned: []models.ReviewReassignment{
+					{PullRequestID: "pr-1", OldReviewerID: "leaving-1", NewReviewerID: "stay-1"},
+				},
+				notReassigned: []models.ReviewReassignment{
+					{PullRequestID: "pr-2", OldReviewerID: "leaving-1"},
+				},
+			},
+			{
+				policy: models.OverflowReject,
+				reassigned: []models.ReviewReassignment{
+					{PullRequestID: "pr-1", OldReviewerID: "leaving-1", NewReviewerID: "stay-1"},
+				},
+				notReassigned: []models.ReviewReassignment{
+					{PullRequestID: "pr-2", OldReviewerID: "leaving-1"},
+				},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(string(tt.policy), func(t *testing.T) {
+				load := map[string]int{"stay-1": 1, "stay-2": 2}
+
+				report := planBulkReassignment(prs, leaving, available, full, load, tt.policy)
+
+				assert.Equal(t, tt.reassigned, report.Reassigned)
+				assert.Equal(t, tt.notReassigned, report.NotReassigned)
+			})
+		}
+	})
+}
@@ -0,0 +1,245 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/437d5/pr-review-manager/internal/domain/repositories"
+)
+
+// ReviewerSelector picks up to count reviewers from candidates.
+// Implementations may use PR repository to look up assignment history.
+type ReviewerSelector interface {
+	Select(
+		ctx context.Context,
+		prs repositories.PullRequestRepository,
+		candidates []models.User,
+		count int,
+	) ([]models.User, error)
+}
+
+func defaultSelectors() map[models.ReviewStrategy]ReviewerSelector {
+	return map[models.ReviewStrategy]ReviewerSelector{
+		models.ReviewStrategyRandom:      RandomSelector{},
+		models.ReviewStrategyRoundRobin:  RoundRobinSelector{},
+		models.ReviewStrategyLeastLoaded: LeastLoadedSelector{},
+		models.ReviewStrategyWeighted:    WeightedSelector{},
+	}
+}
+
+// RandomSelector picks candidates uniformly at random
+type RandomSelector struct{}
+
+func (RandomSelector) Select(
+	_ context.Context,
+	_ repositories.PullRequestRepository,
+	candidates []models.User,
+	count int,
+) ([]models.User, error) {
+	return limit(shuffle(candidates), count), nil
+}
+
+// RoundRobinSelector picks candidates who were assigned least recently,
+// users who were never assigned go first
+type RoundRobinSelector struct{}
+
+func (RoundRobinSelector) Select(
+	ctx context.Context,
+	prs repositories.PullRequestRepository,
+	candidates []models.User,
+	count int,
+) ([]models.User, error) {
+	if len(candidates) == 0 {
+		return []models.User{}, nil
+	}
+
+	lastAssigned, err := prs.GetLastAssignedAt(ctx, userIDs(candidates))
+	if err != nil {
+		return nil, err
+	}
+
+	// candidates come ordered by username, keep that order for equal timestamps
+	sorted := make([]models.User, len(candidates))
+	copy(sorted, candidates)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return lastAssigned[sorted[i].ID].Before(lastAssigned[sorted[j].ID])
+	})
+
+	return limit(sorted, count), nil
+}
+
+// LeastLoadedSelector picks candidates with the fewest open reviews,
+// ties are broken at random
+type LeastLoadedSelector struct{}
+
+func (LeastLoadedSelector) Select(
+	ctx context.Context,
+	prs repositories.PullRequestRepository,
+	candidates []models.User,
+	count int,
+) ([]models.User, error) {
+	if len(candidates) == 0 {
+		return []models.User{}, nil
+	}
+
+	load, err := prs.CountOpenReviews(ctx, userIDs(candidates))
+	if err != nil {
+		return nil, err
+	}
+
+	return selectLeastLoaded(candidates, load, count), nil
+}
+
+// WeightedSelector picks candidates at random with probability
+// inversely proportional to their open reviews count
+type WeightedSelector struct{}
+
+func (WeightedSelector) Select(
+	ctx context.Context,
+	prs repositories.PullRequestRepository,
+	candidates []models.User,
+	count int,
+) ([]models.User, error) {
+	if len(candidates) == 0 {
+		return []models.User{}, nil
+	}
+
+	load, err := prs.CountOpenReviews(ctx, userIDs(candidates))
+	if err != nil {
+		return nil, err
+	}
+
+	pool := make([]models.User, len(candidates))
+	copy(pool, candidates)
+
+	weights := make([]float64, len(pool))
+	var total float64
+	for i, candidate := range pool {
+		weights[i] = 1 / float64(1+load[candidate.ID])
+		total += weights[i]
+	}
+
+	res := make([]models.User, 0, min(count, len(pool)))
+	for len(res) < count && len(pool) > 0 {
+		point := rand.Float64() * total
+
+		picked := len(pool) - 1
+		for i, weight := range weights {
+			if point < weight {
+				picked = i
+				break
+			}
+			point -= weight
+		}
+
+		res = append(res, pool[picked])
+		total -= weights[picked]
+		pool = append(pool[:picked], pool[picked+1:]...)
+		weights = append(weights[:picked], weights[picked+1:]...)
+	}
+
+	return res, nil
+}
+
+// WorkingHoursSelector prefers candidates who are working at Now, then those who start working soonest,
+// candidates who start working at the same time are picked by Selector
+type WorkingHoursSelector struct {
+	Selector ReviewerSelector
+	Now      time.Time
+}
+
+func (s WorkingHoursSelector) Select(
+	ctx context.Context,
+	prs repositories.PullRequestRepository,
+	candidates []models.User,
+	count int,
+) ([]models.User, error) {
+	res := make([]models.User, 0, min(count, len(candidates)))
+	for _, group := range groupByWorkingHours(candidates, s.Now) {
+		if len(res) >= count {
+			break
+		}
+
+		picked, err := s.Selector.Select(ctx, prs, group, count-len(res))
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, picked...)
+	}
+
+	return res, nil
+}
+
+// groupByWorkingHours groups users who start working at the same time, soonest first,
+// users who are working at now or have no working hours form the first group.
+// Users whose working hours cannot be evaluated go last, they are still better than nobody.
+func groupByWorkingHours(users []models.User, now time.Time) [][]models.User {
+	waits := make(map[string]time.Duration, len(users))
+	for _, user := range users {
+		wait, err := user.UntilWorkingHours(now)
+		if err != nil {
+			slog.Warn("cannot evaluate working hours of user", "error", err.Error(), "user_id", user.ID, "timezone", user.Timezone)
+			wait = time.Duration(math.MaxInt64)
+		}
+		waits[user.ID] = wait
+	}
+
+	// keep the order of candidates inside of each group
+	sorted := make([]models.User, len(users))
+	copy(sorted, users)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return waits[sorted[i].ID] < waits[sorted[j].ID]
+	})
+
+	var groups [][]models.User
+	for i, user := range sorted {
+		if i == 0 || waits[user.ID] != waits[sorted[i-1].ID] {
+			groups = append(groups, []models.User{})
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], user)
+	}
+	return groups
+}
+
+func selectLeastLoaded(users []models.User, load map[string]int, count int) []models.User {
+	// shuffle first so that stable sort keeps random order among equally loaded users
+	shuffled := shuffle(users)
+
+	sort.SliceStable(shuffled, func(i, j int) bool {
+		return load[shuffled[i].ID] < load[shuffled[j].ID]
+	})
+
+	return limit(shuffled, count)
+}
+
+func shuffle(users []models.User) []models.User {
+	shuffled := make([]models.User, len(users))
+	copy(shuffled, users)
+
+	rand.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return shuffled
+}
+
+func limit(users []models.User, count int) []models.User {
+	if len(users) <= count {
+		return users
+	}
+	return users[:count]
+}
+
+func userIDs(users []models.User) []string {
+	ids := make([]string, len(users))
+	for i, user := range users {
+		ids[i] = user.ID
+	}
+	return ids
+}
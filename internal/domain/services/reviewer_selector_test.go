@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/437d5/pr-review-manager/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandomSelector_Select(t *testing.T) {
+	ctx := context.Background()
+	selector := RandomSelector{}
+
+	t.Run("empty users", func(t *testing.T) {
+		result, err := selector.Select(ctx, nil, []models.User{}, 2)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("users less than max", func(t *testing.T) {
+		users := []models.User{
+			{ID: "user-1", Username: "User 1"},
+			{ID: "user-2", Username: "User 2"},
+		}
+		result, err := selector.Select(ctx, nil, users, 3)
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+
+	t.Run("users more than max", func(t *testing.T) {
+		users := []models.User{
+			{ID: "user-1", Username: "User 1"},
+			{ID: "user-2", Username: "User 2"},
+			{ID: "user-3", Username: "User 3"},
+			{ID: "user-4", Username: "User 4"},
+		}
+		result, err := selector.Select(ctx, nil, users, 2)
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+}
+
+func TestLeastLoadedSelector_Select(t *testing.T) {
+	ctx := context.Background()
+	selector := LeastLoadedSelector{}
+
+	t.Run("empty users", func(t *testing.T) {
+		result, err := selector.Select(ctx, nil, []models.User{}, 2)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("picks least loaded", func(t *testing.T) {
+		mockPR := &mocks.MockPRRepository{}
+		users := []models.User{
+			{ID: "user-1"},
+			{ID: "user-2"},
+			{ID: "user-3"},
+			{ID: "user-4"},
+		}
+		mockPR.On("CountOpenReviews", ctx, []string{"user-1", "user-2", "user-3", "user-4"}).
+			Return(map[string]int{"user-1": 3, "user-2": 0, "user-3": 2, "user-4": 1}, nil)
+
+		result, err := selector.Select(ctx, mockPR, users, 2)
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, "user-2", result[0].ID)
+		assert.Equal(t, "user-4", result[1].ID)
+	})
+
+	t.Run("ties are broken among equally loaded", func(t *testing.T) {
+		mockPR := &mocks.MockPRRepository{}
+		users := []models.User{
+			{ID: "user-1"},
+			{ID: "user-2"},
+			{ID: "user-3"},
+		}
+		mockPR.On("CountOpenReviews", ctx, []string{"user-1", "user-2", "user-3"}).
+			Return(map[string]int{"user-1": 1, "user-2": 1, "user-3": 4}, nil)
+
+		result, err := selector.Select(ctx, mockPR, users, 1)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Contains(t, []string{"user-1", "user-2"}, result[0].ID)
+	})
+}
+
+func TestRoundRobinSelector_Select(t *testing.T) {
+	ctx := context.Background()
+	selector := RoundRobinSelector{}
+	mockPR := &mocks.MockPRRepository{}
+
+	now := time.Now()
+	users := []models.User{
+		{ID: "user-1"},
+		{ID: "user-2"},
+		{ID: "user-3"},
+		{ID: "user-4"},
+	}
+	mockPR.On("GetLastAssignedAt", ctx, []string{"user-1", "user-2", "user-3", "user-4"}).
+		Return(map[string]time.Time{
+			"user-1": now.Add(-time.Hour),
+			"user-2": now.Add(-3 * time.Hour),
+			"user-4": now,
+		}, nil)
+
+	result, err := selector.Select(ctx, mockPR, users, 3)
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	assert.Equal(t, "user-3", result[0].ID, "never assigned user goes first")
+	assert.Equal(t, "user-2", result[1].ID)
+	assert.Equal(t, "user-1", result[2].ID)
+}
+
+func TestWorkingHoursSelector_Select(t *testing.T) {
+	ctx := context.Background()
+	mockPR := &mocks.MockPRRepository{}
+
+	// 12:00 UTC is 15:00 in Moscow, 08:00 in New York and 21:00 in Tokyo
+	now := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+	dayShift := &models.WorkingHours{Start: "09:00", End: "18:00"}
+	users := []models.User{
+		{ID: "user-tokyo", Timezone: "Asia/Tokyo", WorkingHours: dayShift},
+		{ID: "user-ny", Timezone: "America/New_York", WorkingHours: dayShift},
+		{ID: "user-msk", Timezone: "Europe/Moscow", WorkingHours: dayShift},
+		{ID: "user-any"},
+	}
+
+	// nobody was assigned yet so round robin keeps the order of each group
+	mockPR.On("GetLastAssignedAt", ctx, mock.Anything).Return(map[string]time.Time{}, nil)
+
+	selector := WorkingHoursSelector{Selector: RoundRobinSelector{}, Now: now}
+
+	t.Run("working candidates go first", func(t *testing.T) {
+		result, err := selector.Select(ctx, mockPR, users, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user-msk", "user-any"}, userIDs(result))
+	})
+
+	t.Run("then those who start soonest", func(t *testing.T) {
+		result, err := selector.Select(ctx, mockPR, users, 4)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user-msk", "user-any", "user-ny", "user-tokyo"}, userIDs(result))
+	})
+
+	t.Run("invalid timezone goes last", func(t *testing.T) {
+		broken := models.User{ID: "user-broken", Timezone: "Mars/Olympus", WorkingHours: dayShift}
+
+		result, err := selector.Select(ctx, mockPR, append([]models.User{broken}, users...), 5)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"user-msk", "user-any", "user-ny", "user-tokyo", "user-broken"}, userIDs(result))
+	})
+
+	t.Run("empty users", func(t *testing.T) {
+		result, err := selector.Select(ctx, mockPR, []models.User{}, 2)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
+func TestWeightedSelector_Select(t *testing.T) {
+	ctx := context.Background()
+	selector := WeightedSelector{}
+	mockPR := &mocks.MockPRRepository{}
+
+	users := []models.User{
+		{ID: "user-1"},
+		{ID: "user-2"},
+		{ID: "user-3"},
+	}
+	mockPR.On("CountOpenReviews", ctx, []string{"user-1", "user-2", "user-3"}).
+		Return(map[string]int{"user-1": 0, "user-2": 5, "user-3": 1}, nil)
+
+	result, err := selector.Select(ctx, mockPR, users, 2)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.NotEqual(t, result[0].ID, result[1].ID)
+
+	result, err = selector.Select(ctx, mockPR, users, 5)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, users, result)
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/437d5/pr-review-manager/internal/domain/repositories"
+)
+
+type StatsService struct {
+	uowFactory func(context.Context) (repositories.UnitOfWork, error)
+}
+
+func NewStatsService(uowFactory func(ctx context.Context) (repositories.UnitOfWork, error)) *StatsService {
+	return &StatsService{
+		uowFactory: uowFactory,
+	}
+}
+
+// GetReviewerStats returns assignment statistics per user
+func (s *StatsService) GetReviewerStats(ctx context.Context, filter models.StatsFilter) ([]models.ReviewerStats, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer uow.Close()
+
+	if filter.TeamName != "" {
+		exists, err := uow.Teams().Exists(ctx, filter.TeamName)
+		if err != nil {
+			slog.Error("cannot check team existence", "error", err.Error(), "team", filter.TeamName)
+			return nil, err
+		}
+		if !exists {
+			return nil, models.ErrTeamNotFound
+		}
+	}
+
+	stats, err := uow.Stats().GetReviewerStats(ctx, filter)
+	if err != nil {
+		slog.Error("cannot get reviewer stats", "error", err.Error(), "team", filter.TeamName)
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// GetTeamStats returns PR flow and review load spread of the team
+// for the last weeks, zero weeks means default
+func (s *StatsService) GetTeamStats(ctx context.Context, teamName string, weeks int) (models.TeamStats, error) {
+	if teamName == "" {
+		return models.TeamStats{}, models.ErrTeamNameEmpty
+	}
+	if weeks == 0 {
+		weeks = models.DefaultStatsWeeks
+	}
+	if weeks < 1 || weeks > models.MaxStatsWeeks {
+		return models.TeamStats{}, models.ErrInvalidWeeks
+	}
+
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return models.TeamStats{}, err
+	}
+	defer uow.Close()
+
+	team, err := uow.Teams().GetByName(ctx, teamName)
+	if err != nil {
+		if !errors.Is(err, models.ErrTeamNotFound) {
+			slog.Error("cannot get team", "error", err.Error(), "team", teamName)
+		}
+		return models.TeamStats{}, err
+	}
+
+	stats, err := uow.Stats().GetTeamPRStats(ctx, teamName)
+	if err != nil {
+		slog.Error("cannot get team PR stats", "error", err.Error(), "team", teamName)
+		return models.TeamStats{}, err
+	}
+
+	stats.MergedPerWeek, err = uow.Stats().GetMergedPerWeek(ctx, teamName, weeks)
+	if err != nil {
+		slog.Error("cannot get merged PRs per week", "error", err.Error(), "team", teamName)
+		return models.TeamStats{}, err
+	}
+
+	var activeIDs []string
+	for _, member := range team.Members {
+		if member.IsActive {
+			activeIDs = append(activeIDs, member.ID)
+		}
+	}
+
+	load, err := uow.PR().CountOpenReviews(ctx, activeIDs)
+	if err != nil {
+		slog.Error("cannot count open reviews", "error", err.Error(), "team", teamName)
+		return models.TeamStats{}, err
+	}
+
+	loads := make([]int, len(activeIDs))
+	for i, id := range activeIDs {
+		loads[i] = load[id]
+	}
+	stats.ReviewLoad = models.NewReviewLoadSpread(loads)
+
+	return stats, nil
+}
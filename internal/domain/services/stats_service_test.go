@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/437d5/pr-review-manager/internal/domain/repositories"
+	"github.com/437d5/pr-review-manager/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsService_GetReviewerStats(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("team stats", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockTeams := &mocks.MockTeamRepository{}
+		mockStats := &mocks.MockStatsRepository{}
+
+		service := NewStatsService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		avg := 3600.0
+		filter := models.StatsFilter{TeamName: "backend"}
+		stats := []models.ReviewerStats{
+			{UserID: "u2", TeamName: "backend", TotalAssignments: 3, OpenAssignments: 1, ReassignedAway: 1, AvgTimeToMergeSeconds: &avg},
+		}
+
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockUOW.On("Stats").Return(mockStats)
+		mockTeams.On("Exists", ctx, "backend").Return(true, nil)
+		mockStats.On("GetReviewerStats", ctx, filter).Return(stats, nil)
+
+		result, err := service.GetReviewerStats(ctx, filter)
+
+		require.NoError(t, err)
+		assert.Equal(t, stats, result)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockTeams := &mocks.MockTeamRepository{}
+
+		service := NewStatsService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockTeams.On("Exists", ctx, "missing").Return(false, nil)
+
+		_, err := service.GetReviewerStats(ctx, models.StatsFilter{TeamName: "missing"})
+
+		assert.Equal(t, models.ErrTeamNotFound, err)
+	})
+
+	t.Run("inverted window", func(t *testing.T) {
+		service := NewStatsService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			t.Fatal("unit of work must not be created")
+			return nil, nil
+		})
+
+		from := time.Now()
+		to := from.Add(-time.Hour)
+
+		_, err := service.GetReviewerStats(ctx, models.StatsFilter{From: &from, To: &to})
+
+		assert.Equal(t, models.ErrInvalidTimeRange, err)
+	})
+}
+
+func TestStatsService_GetTeamStats(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("load spread over active members", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockTeams := &mocks.MockTeamRepository{}
+		mockStats := &mocks.MockStatsRepository{}
+		mockPR := &mocks.MockPRRepository{}
+
+		service := NewStatsService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		team := models.Team{
+			Name: "backend",
+			Members: []models.User{
+				{ID: "u1", IsActive: true},
+				{ID: "u2", IsActive: true},
+				{ID: "u3", IsActive: false},
+			},
+		}
+		median := 7200.0
+		weekly := []models.WeeklyCount{{WeekStart: "2025-10-20", Count: 2}}
+
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockUOW.On("Stats").Return(mockStats)
+		mockUOW.On("PR").Return(mockPR)
+		mockTeams.On("GetByName", ctx, "backend").Return(team, nil)
+		mockStats.On("GetTeamPRStats", ctx, "backend").
+			Return(models.TeamStats{TeamName: "backend", OpenPRs: 3, MedianTimeToMergeSeconds: &median}, nil)
+		mockStats.On("GetMergedPerWeek", ctx, "backend", models.DefaultStatsWeeks).Return(weekly, nil)
+		mockPR.On("CountOpenReviews", ctx, []string{"u1", "u2"}).Return(map[string]int{"u1": 1, "u2": 3}, nil)
+
+		stats, err := service.GetTeamStats(ctx, "backend", 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, stats.OpenPRs)
+		assert.Equal(t, weekly, stats.MergedPerWeek)
+		assert.Equal(t, models.ReviewLoadSpread{Members: 2, Min: 1, Max: 3, StdDev: 1}, stats.ReviewLoad)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockTeams := &mocks.MockTeamRepository{}
+
+		service := NewStatsService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockTeams.On("GetByName", ctx, "missing").Return(models.Team{}, models.ErrTeamNotFound)
+
+		_, err := service.GetTeamStats(ctx, "missing", 4)
+
+		assert.Equal(t, models.ErrTeamNotFound, err)
+	})
+
+	t.Run("invalid weeks", func(t *testing.T) {
+		service := NewStatsService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			t.Fatal("unit of work must not be created")
+			return nil, nil
+		})
+
+		_, err := service.GetTeamStats(ctx, "backend", models.MaxStatsWeeks+1)
+
+		assert.Equal(t, models.ErrInvalidWeeks, err)
+	})
+}
@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"sort"
+	"time"
 
 	"github.com/437d5/pr-review-manager/internal/domain/models"
 	"github.com/437d5/pr-review-manager/internal/domain/repositories"
@@ -12,20 +15,37 @@ import (
 
 type TeamService struct {
 	uowFactory func(context.Context) (repositories.UnitOfWork, error)
+	now        func() time.Time
+	// consumers of the outbox that get messages about recorded events
+	consumers []string
 }
 
 func NewTeamService(uowFactory func(ctx context.Context) (repositories.UnitOfWork, error)) *TeamService {
 	return &TeamService{
 		uowFactory: uowFactory,
+		now:        time.Now,
+		consumers:  models.OutboxConsumers(),
 	}
 }
 
+// SetOutboxConsumers limits consumers the service puts outbox messages for,
+// messages for a consumer that is not running would never be delivered
+func (s *TeamService) SetOutboxConsumers(consumers ...string) {
+	s.consumers = consumers
+}
+
 func (s *TeamService) CreateTeam(ctx context.Context, team models.Team) (models.Team, error) {
 	if err := team.Validate(); err != nil {
 		slog.Error("invalid team", "error", err.Error(), "team", team.Name)
 		return models.Team{}, err
 	}
 
+	team.Settings = team.Settings.WithDefaults()
+	if err := team.Settings.Validate(); err != nil {
+		slog.Error("invalid team settings", "error", err.Error(), "team", team.Name)
+		return models.Team{}, err
+	}
+
 	uow, err := s.uowFactory(ctx)
 	if err != nil {
 		return models.Team{}, err
@@ -138,3 +158,373 @@ func (s *TeamService) GetTeam(ctx context.Context, name string) (models.Team, er
 
 	return team, nil
 }
+
+// UpdateSettings changes settings of the team that are set in patch, the rest are kept
+func (s *TeamService) UpdateSettings(ctx context.Context, name string, patch models.TeamSettingsPatch) (models.TeamSettings, error) {
+	if name == "" {
+		return models.TeamSettings{}, models.ErrTeamNameEmpty
+	}
+
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return models.TeamSettings{}, err
+	}
+	defer uow.Close()
+
+	if err := uow.Begin(ctx); err != nil {
+		slog.Error("cannot begin transaction", "error", err.Error())
+		return models.TeamSettings{}, err
+	}
+
+	var updated models.TeamSettings
+	err = func() error {
+		current, err := uow.Teams().GetSettings(ctx, name)
+		if err != nil {
+			if !errors.Is(err, models.ErrTeamNotFound) {
+				slog.Error("cannot get team settings", "error", err.Error(), "team", name)
+			}
+			return err
+		}
+
+		settings := patch.Apply(current)
+		if err := settings.Validate(); err != nil {
+			return err
+		}
+
+		updated, err = uow.Teams().UpdateSettings(ctx, name, settings)
+		if err != nil {
+			if !errors.Is(err, models.ErrTeamNotFound) {
+				slog.Error("cannot update team settings", "error", err.Error(), "team", name)
+			}
+			return err
+		}
+		return nil
+	}()
+
+	if err != nil {
+		if err := uow.Rollback(); err != nil {
+			slog.Error("cannot rollback transaction", "error", err.Error())
+			return models.TeamSettings{}, err
+		}
+		return models.TeamSettings{}, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		slog.Error("cannot commit transaction", "error", err.Error())
+		return models.TeamSettings{}, err
+	}
+
+	slog.Info("team settings updated",
+		"team", name,
+		"strategy", updated.ReviewStrategy,
+		"reviewers_count", updated.ReviewersCount,
+	)
+
+	return updated, nil
+}
+
+// DeactivateUsers deactivates members of the team in one transaction and moves
+// their open reviews to the least loaded teammates who stay active
+func (s *TeamService) DeactivateUsers(
+	ctx context.Context,
+	name string,
+	memberIDs []string,
+) ([]models.User, models.ReassignmentReport, error) {
+	if name == "" {
+		return nil, models.ReassignmentReport{}, models.ErrTeamNameEmpty
+	}
+
+	ids := make([]string, 0, len(memberIDs))
+	leaving := make(map[string]struct{}, len(memberIDs))
+	for _, id := range memberIDs {
+		if id == "" {
+			return nil, models.ReassignmentReport{}, models.ErrEmptyUserID
+		}
+		if _, ok := leaving[id]; ok {
+			continue
+		}
+		leaving[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, models.ReassignmentReport{}, models.ErrEmptyUserIDs
+	}
+
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return nil, models.ReassignmentReport{}, err
+	}
+	defer uow.Close()
+
+	if err := uow.Begin(ctx); err != nil {
+		slog.Error("cannot begin transaction", "error", err.Error())
+		return nil, models.ReassignmentReport{}, err
+	}
+
+	var deactivated []models.User
+	var report models.ReassignmentReport
+	err = func() error {
+		exists, err := uow.Teams().Exists(ctx, name)
+		if err != nil {
+			slog.Error("cannot check team existence", "error", err.Error(), "team", name)
+			return err
+		}
+		if !exists {
+			return models.ErrTeamNotFound
+		}
+
+		deactivated, err = uow.Users().SetTeamIsActive(ctx, name, ids, false)
+		if err != nil {
+			slog.Error("cannot deactivate users", "error", err.Error(), "team", name)
+			return err
+		}
+		if len(deactivated) != len(ids) {
+			slog.Warn("some users are not members of the team", "team", name,
+				"requested", len(ids), "found", len(deactivated))
+			return models.ErrUserNotInTeam
+		}
+
+		settings, err := uow.Teams().GetSettings(ctx, name)
+		if err != nil {
+			slog.Error("cannot get team settings", "error", err.Error(), "team", name)
+			return err
+		}
+
+		// deactivated users are already inactive, absent members are skipped as well
+		candidates, err := uow.Users().GetActiveMembersByTeamName(ctx, name)
+		if err != nil {
+			slog.Error("cannot get team members", "error", err.Error(), "team", name)
+			return err
+		}
+
+		prs, err := uow.PR().GetOpenByReviewers(ctx, ids)
+		if err != nil {
+			slog.Error("cannot get open reviews", "error", err.Error(), "team", name)
+			return err
+		}
+
+		load := make(map[string]int, len(candidates))
+		for _, candidate := range candidates {
+			load[candidate.ID] = candidate.OpenReviews
+		}
+
+		// deactivation is never rejected, with OverflowReject reviews are left to be reassigned by hand
+		available, full := splitByCapacity(candidates)
+		report = planBulkReassignment(prs, leaving, available, full, load, settings.OverflowPolicy)
+
+		if err := uow.PR().ReassignBulk(ctx, report.Reassigned); err != nil {
+			slog.Error("cannot reassign reviews", "error", err.Error(), "team", name)
+			return err
+		}
+
+		events := make([]models.AssignmentEvent, 0, len(deactivated)+len(report.Reassigned))
+		for _, user := range deactivated {
+			events = append(events, models.AssignmentEvent{Type: models.EventUserDeactivated, UserID: user.ID})
+		}
+		for _, reassignment := range report.Reassigned {
+			events = append(events, models.AssignmentEvent{
+				Type:          models.EventReviewerReassigned,
+				PullRequestID: reassignment.PullRequestID,
+				UserID:        reassignment.NewReviewerID,
+				OldUserID:     reassignment.OldReviewerID,
+				Reason:        models.ReasonTeamDeactivated,
+			})
+		}
+		if err := recordEvents(ctx, uow, s.now(), s.consumers, events...); err != nil {
+			return err
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		if err := uow.Rollback(); err != nil {
+			slog.Error("cannot rollback transaction", "error", err.Error())
+			return nil, models.ReassignmentReport{}, fmt.Errorf("rollback failed: %w", err)
+		}
+		return nil, models.ReassignmentReport{}, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		slog.Error("cannot commit transaction", "error", err.Error())
+		return nil, models.ReassignmentReport{}, fmt.Errorf("failed to commit users deactivation: %w", err)
+	}
+
+	slog.Info("team users deactivated",
+		"team", name,
+		"deactivated", len(deactivated),
+		"reassigned", len(report.Reassigned),
+		"not_reassigned", len(report.NotReassigned),
+	)
+
+	return deactivated, report, nil
+}
+
+// ValidateCodeOwners checks CODEOWNERS content without storing it,
+// owners must be members of the team
+func (s *TeamService) ValidateCodeOwners(ctx context.Context, name, content string) (models.CodeOwnersReport, error) {
+	if name == "" {
+		return models.CodeOwnersReport{}, models.ErrTeamNameEmpty
+	}
+
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return models.CodeOwnersReport{}, err
+	}
+	defer uow.Close()
+
+	team, err := uow.Teams().GetByName(ctx, name)
+	if err != nil {
+		if !errors.Is(err, models.ErrTeamNotFound) {
+			slog.Error("cannot get team", "error", err.Error(), "team", name)
+		}
+		return models.CodeOwnersReport{}, err
+	}
+
+	return validateCodeOwners(team, content), nil
+}
+
+// SetCodeOwners replaces CODEOWNERS rules of the team,
+// invalid content is rejected as a whole
+func (s *TeamService) SetCodeOwners(ctx context.Context, name, content string) ([]models.OwnershipRule, error) {
+	if name == "" {
+		return nil, models.ErrTeamNameEmpty
+	}
+
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer uow.Close()
+
+	if err := uow.Begin(ctx); err != nil {
+		slog.Error("cannot begin transaction", "error", err.Error())
+		return nil, err
+	}
+
+	var report models.CodeOwnersReport
+	err = func() error {
+		team, err := uow.Teams().GetByName(ctx, name)
+		if err != nil {
+			if !errors.Is(err, models.ErrTeamNotFound) {
+				slog.Error("cannot get team", "error", err.Error(), "team", name)
+			}
+			return err
+		}
+
+		report = validateCodeOwners(team, content)
+		if !report.Valid {
+			slog.Warn("codeowners rejected", "team", name, "problems", len(report.Problems))
+			return models.ErrInvalidCodeOwners
+		}
+
+		if err := uow.Teams().ReplaceOwnershipRules(ctx, name, report.Rules); err != nil {
+			slog.Error("cannot replace ownership rules", "error", err.Error(), "team", name)
+			return err
+		}
+
+		slog.Info("codeowners updated", "team", name, "rules", len(report.Rules))
+		return nil
+	}()
+
+	if err != nil {
+		if err := uow.Rollback(); err != nil {
+			slog.Error("cannot rollback transaction", "error", err.Error())
+			return nil, err
+		}
+		return nil, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		slog.Error("cannot commit transaction", "error", err.Error())
+		return nil, err
+	}
+
+	return report.Rules, nil
+}
+
+func validateCodeOwners(team models.Team, content string) models.CodeOwnersReport {
+	rules, problems := models.ParseCodeOwners(content)
+
+	members := make(map[string]struct{}, len(team.Members))
+	for _, member := range team.Members {
+		members[member.ID] = struct{}{}
+	}
+
+	for _, rule := range rules {
+		for _, owner := range rule.Owners {
+			if _, ok := members[owner]; !ok {
+				problems = append(problems, models.CodeOwnersProblem{
+					Line:    rule.Line,
+					Message: fmt.Sprintf("owner %q is not a member of team %s", owner, team.Name),
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Line < problems[j].Line
+	})
+
+	return models.CodeOwnersReport{
+		Valid:    len(problems) == 0,
+		Rules:    rules,
+		Problems: problems,
+	}
+}
+
+// SetPartners replaces partner teams of the team,
+// their members are assigned when the team is short of reviewers
+func (s *TeamService) SetPartners(ctx context.Context, name string, partners []string) ([]string, error) {
+	if name == "" {
+		return nil, models.ErrTeamNameEmpty
+	}
+
+	partners = slices.Compact(slices.Sorted(slices.Values(partners)))
+	for _, partner := range partners {
+		if partner == "" {
+			return nil, models.ErrTeamNameEmpty
+		}
+		if partner == name {
+			return nil, models.ErrPartnerTeamSelf
+		}
+	}
+
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer uow.Close()
+
+	if err := uow.Begin(ctx); err != nil {
+		slog.Error("cannot begin transaction", "error", err.Error())
+		return nil, err
+	}
+
+	err = func() error {
+		if err := uow.Teams().ReplacePartners(ctx, name, partners); err != nil {
+			if !errors.Is(err, models.ErrTeamNotFound) {
+				slog.Error("cannot replace partner teams", "error", err.Error(), "team", name)
+			}
+			return err
+		}
+
+		slog.Info("partner teams updated", "team", name, "partners", partners)
+		return nil
+	}()
+
+	if err != nil {
+		if err := uow.Rollback(); err != nil {
+			slog.Error("cannot rollback transaction", "error", err.Error())
+			return nil, err
+		}
+		return nil, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		slog.Error("cannot commit transaction", "error", err.Error())
+		return nil, err
+	}
+
+	return partners, nil
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/437d5/pr-review-manager/internal/domain/models"
@@ -33,6 +34,7 @@ func TestTeamService_CreateTeam(t *testing.T) {
 		}
 
 		createdTeam := team
+		createdTeam.Settings = models.DefaultTeamSettings()
 
 		mockUOW.On("Begin", ctx).Return(nil).Once()
 		mockUOW.On("Commit").Return(nil).Once()
@@ -41,7 +43,7 @@ func TestTeamService_CreateTeam(t *testing.T) {
 		mockUOW.On("Users").Return(mockUsers)
 
 		mockTeams.On("Exists", ctx, "backend-team").Return(false, nil).Once()
-		mockTeams.On("Create", ctx, team).Return(1, nil).Once()
+		mockTeams.On("Create", ctx, createdTeam).Return(1, nil).Once()
 		mockUsers.On("GetByID", ctx, "user-1").Return(models.User{}, models.ErrUserNotFound).Once()
 		mockUsers.On("Create", ctx, team.Members[0], 1).Return(nil).Once()
 		mockUsers.On("GetByID", ctx, "user-2").Return(models.User{}, models.ErrUserNotFound).Once()
@@ -52,6 +54,7 @@ func TestTeamService_CreateTeam(t *testing.T) {
 
 		require.NoError(t, err)
 		assert.Equal(t, createdTeam.Name, result.Name)
+		assert.Equal(t, models.DefaultTeamSettings(), result.Settings)
 		assert.Len(t, result.Members, 2)
 		for i := range result.Members {
 			assert.Equal(t, createdTeam.Members[i].ID, result.Members[i].ID)
@@ -96,6 +99,32 @@ func TestTeamService_CreateTeam(t *testing.T) {
 	})
 }
 
+func TestTeamService_CreateTeam_InvalidSettings(t *testing.T) {
+	ctx := context.Background()
+	mockUOW := &mocks.MockUnitOfWork{}
+
+	service := NewTeamService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+		return mockUOW, nil
+	})
+
+	team := models.Team{
+		Name: "backend-team",
+		Members: []models.User{
+			{ID: "user-1", Username: "User 1", IsActive: true},
+		},
+		Settings: models.TeamSettings{
+			ReviewStrategy: "UNKNOWN",
+			ReviewersCount: 2,
+		},
+	}
+
+	result, err := service.CreateTeam(ctx, team)
+
+	assert.Equal(t, models.ErrInvalidReviewStrategy, err)
+	assert.Equal(t, models.Team{}, result)
+	mockUOW.AssertNotCalled(t, "Begin", ctx)
+}
+
 func TestTeamService_GetTeam(t *testing.T) {
 	ctx := context.Background()
 
@@ -145,3 +174,417 @@ func TestTeamService_GetTeam(t *testing.T) {
 		assert.Equal(t, models.Team{}, result)
 	})
 }
+
+func TestTeamService_UpdateSettings(t *testing.T) {
+	ctx := context.Background()
+
+	stored := models.TeamSettings{
+		ReviewStrategy:          models.ReviewStrategyLeastLoaded,
+		ReviewersCount:          2,
+		RequiredApprovals:       1,
+		BlockOnChangesRequested: true,
+		OverflowPolicy:          models.OverflowReject,
+	}
+	strategy := models.ReviewStrategyRoundRobin
+	count := 3
+
+	t.Run("partial update keeps other settings", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockTeams := &mocks.MockTeamRepository{}
+
+		service := NewTeamService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		expected := stored
+		expected.ReviewStrategy = models.ReviewStrategyRoundRobin
+		expected.ReviewersCount = 3
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Teams").Return(mockTeams)
+
+		mockTeams.On("GetSettings", ctx, "backend-team").Return(stored, nil)
+		mockTeams.On("UpdateSettings", ctx, "backend-team", expected).Return(expected, nil)
+
+		result, err := service.UpdateSettings(ctx, "backend-team", models.TeamSettingsPatch{
+			ReviewStrategy: &strategy,
+			ReviewersCount: &count,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+		mockTeams.AssertExpectations(t)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockTeams := &mocks.MockTeamRepository{}
+
+		service := NewTeamService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Rollback").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Teams").Return(mockTeams)
+
+		mockTeams.On("GetSettings", ctx, "missing").Return(models.TeamSettings{}, models.ErrTeamNotFound)
+
+		result, err := service.UpdateSettings(ctx, "missing", models.TeamSettingsPatch{ReviewStrategy: &strategy})
+
+		assert.Equal(t, models.ErrTeamNotFound, err)
+		assert.Equal(t, models.TeamSettings{}, result)
+	})
+
+	t.Run("invalid reviewers count", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockTeams := &mocks.MockTeamRepository{}
+
+		service := NewTeamService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Rollback").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Teams").Return(mockTeams)
+
+		mockTeams.On("GetSettings", ctx, "backend-team").Return(stored, nil)
+
+		zero := 0
+		result, err := service.UpdateSettings(ctx, "backend-team", models.TeamSettingsPatch{ReviewersCount: &zero})
+
+		assert.Equal(t, models.ErrInvalidReviewersCount, err)
+		assert.Equal(t, models.TeamSettings{}, result)
+		mockTeams.AssertNotCalled(t, "UpdateSettings", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTeamService_SetCodeOwners(t *testing.T) {
+	ctx := context.Background()
+
+	team := models.Team{
+		Name: "backend",
+		Members: []models.User{
+			{ID: "alice", TeamName: "backend"},
+			{ID: "bob", TeamName: "backend"},
+		},
+	}
+
+	t.Run("rules are replaced", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockTeams := &mocks.MockTeamRepository{}
+
+		service := NewTeamService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		rules := []models.OwnershipRule{
+			{Line: 1, Pattern: "*", Owners: []string{"alice"}},
+			{Line: 2, Pattern: "/api/", Owners: []string{"bob"}},
+		}
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockTeams.On("GetByName", ctx, "backend").Return(team, nil)
+		mockTeams.On("ReplaceOwnershipRules", ctx, "backend", rules).Return(nil)
+
+		result, err := service.SetCodeOwners(ctx, "backend", "* @alice\n/api/ @bob\n")
+
+		require.NoError(t, err)
+		assert.Equal(t, rules, result)
+		mockTeams.AssertExpectations(t)
+		mockUOW.AssertExpectations(t)
+	})
+
+	t.Run("invalid content is rejected", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockTeams := &mocks.MockTeamRepository{}
+
+		service := NewTeamService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Rollback").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockTeams.On("GetByName", ctx, "backend").Return(team, nil)
+
+		_, err := service.SetCodeOwners(ctx, "backend", "* @carol\n")
+
+		assert.Equal(t, models.ErrInvalidCodeOwners, err)
+		mockTeams.AssertNotCalled(t, "ReplaceOwnershipRules", mock.Anything, mock.Anything, mock.Anything)
+		mockUOW.AssertExpectations(t)
+	})
+}
+
+func TestTeamService_ValidateCodeOwners(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reports problems", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockTeams := &mocks.MockTeamRepository{}
+
+		service := NewTeamService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockTeams.On("GetByName", ctx, "backend").Return(models.Team{
+			Name:    "backend",
+			Members: []models.User{{ID: "alice"}},
+		}, nil)
+
+		report, err := service.ValidateCodeOwners(ctx, "backend", "/api/ @carol\n!docs/ @alice\n*.go @alice\n")
+
+		require.NoError(t, err)
+		assert.False(t, report.Valid)
+		assert.Equal(t, []models.OwnershipRule{
+			{Line: 1, Pattern: "/api/", Owners: []string{"carol"}},
+			{Line: 3, Pattern: "*.go", Owners: []string{"alice"}},
+		}, report.Rules)
+		require.Len(t, report.Problems, 2)
+		assert.Equal(t, 1, report.Problems[0].Line)
+		assert.Equal(t, 2, report.Problems[1].Line)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockTeams := &mocks.MockTeamRepository{}
+
+		service := NewTeamService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockTeams.On("GetByName", ctx, "missing").Return(models.Team{}, models.ErrTeamNotFound)
+
+		_, err := service.ValidateCodeOwners(ctx, "missing", "* @alice")
+
+		assert.Equal(t, models.ErrTeamNotFound, err)
+	})
+}
+
+func TestTeamService_SetPartners(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("partners are replaced", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockTeams := &mocks.MockTeamRepository{}
+
+		service := NewTeamService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockTeams.On("ReplacePartners", ctx, "backend", []string{"mobile", "platform"}).Return(nil)
+
+		result, err := service.SetPartners(ctx, "backend", []string{"platform", "mobile", "platform"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"mobile", "platform"}, result)
+		mockTeams.AssertExpectations(t)
+	})
+
+	t.Run("partner not found", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockTeams := &mocks.MockTeamRepository{}
+
+		service := NewTeamService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Rollback").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Teams").Return(mockTeams)
+		mockTeams.On("ReplacePartners", ctx, "backend", []string{"missing"}).Return(models.ErrTeamNotFound)
+
+		_, err := service.SetPartners(ctx, "backend", []string{"missing"})
+
+		assert.Equal(t, models.ErrTeamNotFound, err)
+		mockUOW.AssertExpectations(t)
+	})
+
+	t.Run("team cannot be its own partner", func(t *testing.T) {
+		service := NewTeamService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			t.Fatal("uow should not be created for invalid partners")
+			return nil, nil
+		})
+
+		_, err := service.SetPartners(ctx, "backend", []string{"backend"})
+
+		assert.Equal(t, models.ErrPartnerTeamSelf, err)
+	})
+}
+
+func TestTeamService_DeactivateUsers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful deactivation", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockTeams := &mocks.MockTeamRepository{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockPR := &mocks.MockPRRepository{}
+
+		service := NewTeamService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		deactivated := []models.User{
+			{ID: "user-1", IsActive: false, TeamName: "backend-team"},
+			{ID: "user-2", IsActive: false, TeamName: "backend-team"},
+		}
+
+		candidates := []models.User{
+			{ID: "user-3", IsActive: true, TeamName: "backend-team"},
+		}
+
+		openPRs := []models.PullRequest{
+			{ID: "pr-1", AuthorID: "user-5", AssignedReviewers: []string{"user-1", "user-2"}},
+		}
+
+		expectedReassigned := []models.ReviewReassignment{
+			{PullRequestID: "pr-1", OldReviewerID: "user-1", NewReviewerID: "user-3"},
+		}
+
+		mockUOW.On("Begin", ctx).Return(nil).Once()
+		mockUOW.On("Commit").Return(nil).Once()
+		mockUOW.On("Close").Return(nil).Once()
+		mockUOW.On("Teams").Return(mockTeams)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("PR").Return(mockPR)
+
+		mockTeams.On("Exists", ctx, "backend-team").Return(true, nil).Once()
+		mockUsers.On("SetTeamIsActive", ctx, "backend-team", []string{"user-1", "user-2"}, false).
+			Return(deactivated, nil).Once()
+		mockTeams.On("GetSettings", ctx, "backend-team").Return(models.DefaultTeamSettings(), nil).Once()
+		mockUsers.On("GetActiveMembersByTeamName", ctx, "backend-team").Return(candidates, nil).Once()
+		mockPR.On("GetOpenByReviewers", ctx, []string{"user-1", "user-2"}).Return(openPRs, nil).Once()
+		mockPR.On("ReassignBulk", ctx, expectedReassigned).Return(nil).Once()
+		mockEvents := &mocks.MockEventRepository{}
+		mockUOW.On("Events").Return(mockEvents)
+		mockEvents.On("Append", ctx, []models.AssignmentEvent{
+			{Type: models.EventUserDeactivated, UserID: "user-1"},
+			{Type: models.EventUserDeactivated, UserID: "user-2"},
+			{
+				Type:          models.EventReviewerReassigned,
+				PullRequestID: "pr-1",
+				UserID:        "user-3",
+				OldUserID:     "user-1",
+				Reason:        models.ReasonTeamDeactivated,
+			},
+		}).Return(nil).Once()
+		expectOutbox(ctx, mockUOW, models.TopicReviewerReassigned)
+
+		users, report, err := service.DeactivateUsers(ctx, "backend-team", []string{"user-1", "user-2", "user-1"})
+
+		require.NoError(t, err)
+		assert.Equal(t, deactivated, users)
+		assert.Equal(t, expectedReassigned, report.Reassigned)
+		assert.Equal(t, []models.ReviewReassignment{
+			{PullRequestID: "pr-1", OldReviewerID: "user-2"},
+		}, report.NotReassigned)
+		mockUOW.AssertExpectations(t)
+		mockPR.AssertExpectations(t)
+	})
+
+	t.Run("members at capacity are skipped", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockTeams := &mocks.MockTeamRepository{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockPR := &mocks.MockPRRepository{}
+		mockEvents := &mocks.MockEventRepository{}
+
+		service := NewTeamService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		limit := 1
+		candidates := []models.User{
+			{ID: "user-3", IsActive: true, MaxOpenReviews: &limit, OpenReviews: 1},
+			{ID: "user-4", IsActive: true, OpenReviews: 5},
+		}
+		expectedReassigned := []models.ReviewReassignment{
+			{PullRequestID: "pr-1", OldReviewerID: "user-1", NewReviewerID: "user-4"},
+		}
+
+		mockUOW.On("Begin", ctx).Return(nil).Once()
+		mockUOW.On("Commit").Return(nil).Once()
+		mockUOW.On("Close").Return(nil).Once()
+		mockUOW.On("Teams").Return(mockTeams)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Events").Return(mockEvents)
+
+		mockTeams.On("Exists", ctx, "backend-team").Return(true, nil).Once()
+		mockUsers.On("SetTeamIsActive", ctx, "backend-team", []string{"user-1"}, false).
+			Return([]models.User{{ID: "user-1"}}, nil).Once()
+		mockTeams.On("GetSettings", ctx, "backend-team").Return(models.TeamSettings{
+			ReviewStrategy: models.ReviewStrategyLeastLoaded,
+			ReviewersCount: 2,
+			OverflowPolicy: models.OverflowReject,
+		}, nil).Once()
+		mockUsers.On("GetActiveMembersByTeamName", ctx, "backend-team").Return(candidates, nil).Once()
+		mockPR.On("GetOpenByReviewers", ctx, []string{"user-1"}).Return([]models.PullRequest{
+			{ID: "pr-1", AuthorID: "user-5", AssignedReviewers: []string{"user-1"}},
+		}, nil).Once()
+		mockPR.On("ReassignBulk", ctx, expectedReassigned).Return(nil).Once()
+		mockEvents.On("Append", ctx, mock.Anything).Return(nil).Once()
+		expectOutbox(ctx, mockUOW, models.TopicReviewerReassigned)
+
+		_, report, err := service.DeactivateUsers(ctx, "backend-team", []string{"user-1"})
+
+		require.NoError(t, err)
+		assert.Equal(t, expectedReassigned, report.Reassigned)
+		mockPR.AssertExpectations(t)
+	})
+
+	t.Run("user is not a member of the team", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockTeams := &mocks.MockTeamRepository{}
+		mockUsers := &mocks.MockUserRepository{}
+
+		service := NewTeamService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Begin", ctx).Return(nil).Once()
+		mockUOW.On("Rollback").Return(nil).Once()
+		mockUOW.On("Close").Return(nil).Once()
+		mockUOW.On("Teams").Return(mockTeams)
+		mockUOW.On("Users").Return(mockUsers)
+
+		mockTeams.On("Exists", ctx, "backend-team").Return(true, nil).Once()
+		mockUsers.On("SetTeamIsActive", ctx, "backend-team", []string{"user-1", "stranger"}, false).
+			Return([]models.User{{ID: "user-1"}}, nil).Once()
+
+		users, _, err := service.DeactivateUsers(ctx, "backend-team", []string{"user-1", "stranger"})
+
+		assert.Equal(t, models.ErrUserNotInTeam, err)
+		assert.Nil(t, users)
+		mockUOW.AssertExpectations(t)
+	})
+
+	t.Run("empty user ids", func(t *testing.T) {
+		service := NewTeamService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			t.Fatal("uow should not be created for empty user ids")
+			return nil, nil
+		})
+
+		_, _, err := service.DeactivateUsers(ctx, "backend-team", nil)
+
+		assert.Equal(t, models.ErrEmptyUserIDs, err)
+	})
+}
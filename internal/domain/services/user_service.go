@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"slices"
+	"time"
 
 	"github.com/437d5/pr-review-manager/internal/domain/models"
 	"github.com/437d5/pr-review-manager/internal/domain/repositories"
@@ -11,18 +13,182 @@ import (
 
 type UserService struct {
 	uowFactory func(context.Context) (repositories.UnitOfWork, error)
+	selectors  map[models.ReviewStrategy]ReviewerSelector
+	// clock for working hours of replacement reviewers
+	now func() time.Time
+	// consumers of the outbox that get messages about recorded events
+	consumers []string
 }
 
 func NewUserService(uowFactory func(ctx context.Context) (repositories.UnitOfWork, error)) *UserService {
 	return &UserService{
 		uowFactory: uowFactory,
+		selectors:  defaultSelectors(),
+		now:        time.Now,
+		consumers:  models.OutboxConsumers(),
 	}
 }
 
-func (s *UserService) SetIsActive(ctx context.Context, userID string, isActive bool) (models.User, error) {
+// SetOutboxConsumers limits consumers the service puts outbox messages for,
+// messages for a consumer that is not running would never be delivered
+func (s *UserService) SetOutboxConsumers(consumers ...string) {
+	s.consumers = consumers
+}
+
+// SetIsActive changes user activity, on deactivation open reviews of the user
+// are reassigned to active teammates in the same transaction
+func (s *UserService) SetIsActive(ctx context.Context, userID string, isActive bool) (models.User, models.ReassignmentReport, error) {
+	if userID == "" {
+		return models.User{}, models.ReassignmentReport{}, models.ErrEmptyUserID
+	}
+
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return models.User{}, models.ReassignmentReport{}, err
+	}
+	defer uow.Close()
+
+	if err := uow.Begin(ctx); err != nil {
+		slog.Error("cannot begin transaction", "error", err.Error())
+		return models.User{}, models.ReassignmentReport{}, err
+	}
+
+	var user models.User
+	var report models.ReassignmentReport
+	err = func() error {
+		user, err = uow.Users().SetIsActive(ctx, userID, isActive)
+		if err != nil {
+			if errors.Is(err, models.ErrUserNotFound) {
+				return models.ErrUserNotFound
+			}
+			slog.Error("cannot set isActive for user", "error", err.Error(), "id", userID, "is_active", isActive)
+			return err
+		}
+
+		event := models.AssignmentEvent{Type: models.EventUserDeactivated, UserID: userID}
+		if isActive {
+			event.Type = models.EventUserActivated
+		}
+		if err := recordEvents(ctx, uow, s.now(), s.consumers, event); err != nil {
+			return err
+		}
+
+		if isActive {
+			return nil
+		}
+
+		report, err = s.reassignOpenReviews(ctx, uow, user, models.ReasonUserDeactivated)
+		return err
+	}()
+
+	if err != nil {
+		if err := uow.Rollback(); err != nil {
+			slog.Error("cannot rollback transaction", "error", err.Error())
+			return models.User{}, models.ReassignmentReport{}, err
+		}
+		return models.User{}, models.ReassignmentReport{}, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		slog.Error("cannot commit transaction", "error", err.Error())
+		return models.User{}, models.ReassignmentReport{}, err
+	}
+
+	return user, report, nil
+}
+
+// reassignOpenReviews moves open reviews of the user to active teammates
+// using the same rules as PRService.ReassignReviewer
+func (s *UserService) reassignOpenReviews(
+	ctx context.Context,
+	uow repositories.UnitOfWork,
+	user models.User,
+	reason string,
+) (models.ReassignmentReport, error) {
+	report := models.ReassignmentReport{
+		Reassigned:    []models.ReviewReassignment{},
+		NotReassigned: []models.ReviewReassignment{},
+	}
+
+	prs, err := uow.PR().GetOpenByReviewers(ctx, []string{user.ID})
+	if err != nil {
+		slog.Error("cannot get open reviews", "error", err.Error(), "user_id", user.ID)
+		return models.ReassignmentReport{}, err
+	}
+
+	if len(prs) == 0 {
+		return report, nil
+	}
+
+	teammates, err := uow.Users().GetActiveTeammatesByUserID(ctx, user.ID)
+	if err != nil {
+		slog.Error("cannot get teammates", "error", err.Error(), "user_id", user.ID)
+		return models.ReassignmentReport{}, err
+	}
+
+	selector, settings, err := teamSelector(ctx, uow, s.selectors, user.TeamName, s.now())
+	if err != nil {
+		return models.ReassignmentReport{}, err
+	}
+
+	for _, pr := range prs {
+		reassignment := models.ReviewReassignment{
+			PullRequestID: pr.ID,
+			OldReviewerID: user.ID,
+		}
+
+		_, newReviewerID, err := reassignReviewer(ctx, uow, selector, settings.OverflowPolicy, pr, user.ID, teammates,
+			models.ReassignOptions{Reason: reason}, s.now(), s.consumers)
+		if err != nil {
+			// deactivation or absence is never rejected, the review is left to be reassigned by hand
+			if errors.Is(err, models.ErrNoCandidateToReassign) || errors.Is(err, models.ErrReviewersAtCapacity) {
+				slog.Warn("no candidate to reassign review", "pr_id", pr.ID, "user_id", user.ID)
+				report.NotReassigned = append(report.NotReassigned, reassignment)
+				continue
+			}
+			return models.ReassignmentReport{}, err
+		}
+
+		reassignment.NewReviewerID = newReviewerID
+		report.Reassigned = append(report.Reassigned, reassignment)
+
+		// keep capacity of the next candidates up to date
+		for i := range teammates {
+			if teammates[i].ID == newReviewerID {
+				teammates[i].OpenReviews++
+			}
+		}
+	}
+
+	slog.Info("open reviews of user reassigned",
+		"user_id", user.ID,
+		"reason", reason,
+		"reassigned", len(report.Reassigned),
+		"not_reassigned", len(report.NotReassigned),
+	)
+
+	return report, nil
+}
+
+// SetWorkingHours changes timezone and working hours of the user, they are used to prefer
+// reviewers who are working now, nil hours make the user available at any time
+func (s *UserService) SetWorkingHours(
+	ctx context.Context,
+	userID string,
+	timezone string,
+	hours *models.WorkingHours,
+) (models.User, error) {
 	if userID == "" {
 		return models.User{}, models.ErrEmptyUserID
 	}
+	if err := models.ValidateTimezone(timezone); err != nil {
+		return models.User{}, err
+	}
+	if hours != nil {
+		if err := hours.Validate(); err != nil {
+			return models.User{}, err
+		}
+	}
 
 	uow, err := s.uowFactory(ctx)
 	if err != nil {
@@ -30,34 +196,217 @@ func (s *UserService) SetIsActive(ctx context.Context, userID string, isActive b
 	}
 	defer uow.Close()
 
-	user, err := uow.Users().SetIsActive(ctx, userID, isActive)
+	user, err := uow.Users().SetWorkingHours(ctx, userID, timezone, hours)
 	if err != nil {
-		if errors.Is(err, models.ErrUserNotFound) {
-			return models.User{}, models.ErrUserNotFound
+		if !errors.Is(err, models.ErrUserNotFound) {
+			slog.Error("cannot set working hours", "error", err.Error(), "id", userID)
 		}
-		slog.Error("cannot set isActive for user", "error", err.Error(), "id", userID, "is_active", isActive)
 		return models.User{}, err
 	}
 
+	slog.Info("working hours updated", "user_id", userID, "timezone", timezone, "working_hours", hours)
 	return user, nil
 }
 
-func (s *UserService) GetPRs(ctx context.Context, userID string) ([]models.PullRequest, error) {
+// SetAbsence replaces out-of-office periods of the user, absent users are not picked as reviewers.
+// Open reviews of the user are reassigned by AbsenceScheduler once an absence starts.
+func (s *UserService) SetAbsence(ctx context.Context, userID string, absences []models.Absence) ([]models.Absence, error) {
 	if userID == "" {
-		return []models.PullRequest{}, models.ErrEmptyUserID
+		return nil, models.ErrEmptyUserID
+	}
+	if len(absences) > models.MaxAbsencesCount {
+		return nil, models.ErrTooManyAbsences
+	}
+	for _, absence := range absences {
+		if err := absence.Validate(); err != nil {
+			return nil, err
+		}
 	}
 
 	uow, err := s.uowFactory(ctx)
 	if err != nil {
-		return []models.PullRequest{}, err
+		return nil, err
 	}
 	defer uow.Close()
 
-	prs, err := uow.PR().GetPRs(ctx, userID)
+	if err := uow.Begin(ctx); err != nil {
+		slog.Error("cannot begin transaction", "error", err.Error())
+		return nil, err
+	}
+
+	var stored []models.Absence
+	err = func() error {
+		stored, err = uow.Users().ReplaceAbsences(ctx, userID, absences)
+		if err != nil && !errors.Is(err, models.ErrUserNotFound) {
+			slog.Error("cannot replace absences", "error", err.Error(), "user_id", userID)
+		}
+		return err
+	}()
+
+	if err != nil {
+		if err := uow.Rollback(); err != nil {
+			slog.Error("cannot rollback transaction", "error", err.Error())
+			return nil, err
+		}
+		return nil, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		slog.Error("cannot commit transaction", "error", err.Error())
+		return nil, err
+	}
+
+	slog.Info("absences updated", "user_id", userID, "count", len(stored))
+	return stored, nil
+}
+
+// ReassignAbsentReviews moves open reviews of users whose absence has started
+// and returns the number of handled absences, at most limit of them are handled at once.
+// Each absence is handled in its own transaction, the failed ones are skipped
+// and retried on the next call.
+func (s *UserService) ReassignAbsentReviews(ctx context.Context, limit int) (int, error) {
+	var skipped []int64
+	handled := 0
+	for handled < limit {
+		absence, found, err := s.reassignNextAbsence(ctx, skipped)
+		if err != nil {
+			if !found {
+				return handled, err
+			}
+			slog.Error("cannot reassign reviews of absent user, absence is skipped",
+				"error", err.Error(),
+				"absence_id", absence.ID,
+				"user_id", absence.UserID,
+			)
+			skipped = append(skipped, absence.ID)
+			continue
+		}
+		if !found {
+			break
+		}
+		handled++
+	}
+
+	return handled, nil
+}
+
+// reassignNextAbsence reassigns open reviews of the oldest started absence
+// that is not one of skipped, found is false when there is no such absence
+func (s *UserService) reassignNextAbsence(ctx context.Context, skipped []int64) (models.Absence, bool, error) {
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return models.Absence{}, false, err
+	}
+	defer uow.Close()
+
+	if err := uow.Begin(ctx); err != nil {
+		slog.Error("cannot begin transaction", "error", err.Error())
+		return models.Absence{}, false, err
+	}
+
+	var absence models.Absence
+	found := false
+	err = func() error {
+		// skipped absences come first, so one more is enough to get the next one
+		absences, err := uow.Users().GetStartedAbsences(ctx, len(skipped)+1)
+		if err != nil {
+			return err
+		}
+
+		for _, a := range absences {
+			if !slices.Contains(skipped, a.ID) {
+				absence, found = a, true
+				break
+			}
+		}
+		if !found {
+			return nil
+		}
+
+		user, err := uow.Users().GetByID(ctx, absence.UserID)
+		if err != nil {
+			slog.Error("cannot get absent user", "error", err.Error(), "user_id", absence.UserID)
+			return err
+		}
+
+		if _, err := s.reassignOpenReviews(ctx, uow, user, models.ReasonUserAbsent); err != nil {
+			return err
+		}
+
+		return uow.Users().MarkAbsenceReassigned(ctx, absence.ID)
+	}()
+
+	if err != nil {
+		if err := uow.Rollback(); err != nil {
+			slog.Error("cannot rollback transaction", "error", err.Error())
+			return absence, found, err
+		}
+		return absence, found, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		slog.Error("cannot commit transaction", "error", err.Error())
+		return absence, found, err
+	}
+
+	return absence, found, nil
+}
+
+// SetMaxOpenReviews limits number of open reviews the user can get, nil removes the limit
+func (s *UserService) SetMaxOpenReviews(ctx context.Context, userID string, limit *int) (models.User, error) {
+	if userID == "" {
+		return models.User{}, models.ErrEmptyUserID
+	}
+	if limit != nil && *limit < 1 {
+		return models.User{}, models.ErrInvalidMaxOpenReviews
+	}
+
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return models.User{}, err
+	}
+	defer uow.Close()
+
+	user, err := uow.Users().SetMaxOpenReviews(ctx, userID, limit)
+	if err != nil {
+		if !errors.Is(err, models.ErrUserNotFound) {
+			slog.Error("cannot set max open reviews", "error", err.Error(), "id", userID)
+		}
+		return models.User{}, err
+	}
+
+	slog.Info("max open reviews updated", "user_id", userID, "max_open_reviews", limit)
+	return user, nil
+}
+
+// GetPRs returns a page of PRs where the user is a reviewer
+// and the total number of such PRs matching the filter
+func (s *UserService) GetPRs(ctx context.Context, userID string, filter models.PRFilter) (models.PRPage, int, error) {
+	if userID == "" {
+		return models.PRPage{}, 0, models.ErrEmptyUserID
+	}
+	if err := filter.Validate(); err != nil {
+		return models.PRPage{}, 0, err
+	}
+
+	uow, err := s.uowFactory(ctx)
+	if err != nil {
+		return models.PRPage{}, 0, err
+	}
+	defer uow.Close()
+
+	page, err := uow.PR().GetPRs(ctx, userID, filter)
 	if err != nil {
 		slog.Error("cannot get PRs for user", "error", err.Error(), "id", userID)
-		return []models.PullRequest{}, err
+		return models.PRPage{}, 0, err
+	}
+
+	filter.ReviewerID = userID
+	total, err := uow.PR().CountPRs(ctx, filter)
+	if err != nil {
+		slog.Error("cannot count PRs for user", "error", err.Error(), "id", userID)
+		return models.PRPage{}, 0, err
 	}
 
-	return prs, nil
+	return page, total, nil
 }
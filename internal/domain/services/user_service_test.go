@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/437d5/pr-review-manager/internal/domain/models"
 	"github.com/437d5/pr-review-manager/internal/domain/repositories"
@@ -29,14 +31,112 @@ func TestUserService_SetIsActive(t *testing.T) {
 			TeamName: "team-1",
 		}
 
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
 		mockUOW.On("Close").Return(nil)
 		mockUOW.On("Users").Return(mockUsers)
 
 		mockUsers.On("SetIsActive", ctx, "user-1", true).Return(user, nil)
+		mockEvents := &mocks.MockEventRepository{}
+		mockUOW.On("Events").Return(mockEvents)
+		mockEvents.On("Append", ctx, []models.AssignmentEvent{
+			{Type: models.EventUserActivated, UserID: "user-1"},
+		}).Return(nil)
 
-		result, err := service.SetIsActive(ctx, "user-1", true)
+		result, report, err := service.SetIsActive(ctx, "user-1", true)
 		require.NoError(t, err)
 		assert.Equal(t, user, result)
+		assert.Empty(t, report.Reassigned)
+		mockUOW.AssertExpectations(t)
+	})
+
+	t.Run("deactivation reassigns open reviews", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockUsers := &mocks.MockUserRepository{}
+		mockPR := &mocks.MockPRRepository{}
+		mockTeams := &mocks.MockTeamRepository{}
+
+		service := NewUserService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		user := models.User{
+			ID:       "user-1",
+			Username: "user-1",
+			IsActive: false,
+			TeamName: "team-1",
+		}
+
+		openPRs := []models.PullRequest{
+			{ID: "pr-1", AuthorID: "user-2", Status: models.PRStatusOpen, AssignedReviewers: []string{"user-1"}},
+			{ID: "pr-2", AuthorID: "user-3", Status: models.PRStatusOpen, AssignedReviewers: []string{"user-1", "user-2"}},
+		}
+
+		teammates := []models.User{
+			{ID: "user-2", IsActive: true, TeamName: "team-1"},
+			{ID: "user-3", IsActive: true, TeamName: "team-1"},
+		}
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUOW.On("PR").Return(mockPR)
+		mockUOW.On("Teams").Return(mockTeams)
+
+		mockUsers.On("SetIsActive", ctx, "user-1", false).Return(user, nil)
+		mockPR.On("GetOpenByReviewers", ctx, []string{"user-1"}).Return(openPRs, nil)
+		mockUsers.On("GetActiveTeammatesByUserID", ctx, "user-1").Return(teammates, nil)
+		mockTeams.On("GetSettings", ctx, "team-1").Return(models.DefaultTeamSettings(), nil)
+		mockPR.On("CountOpenReviews", ctx, []string{"user-3"}).Return(map[string]int{"user-3": 0}, nil)
+		mockPR.On("Reassign", ctx, "pr-1", "user-1", "user-3").Return(models.PullRequest{ID: "pr-1"}, nil)
+		mockEvents := &mocks.MockEventRepository{}
+		mockUOW.On("Events").Return(mockEvents)
+		mockEvents.On("Append", ctx, []models.AssignmentEvent{
+			{Type: models.EventUserDeactivated, UserID: "user-1"},
+		}).Return(nil)
+		mockEvents.On("Append", ctx, []models.AssignmentEvent{{
+			Type:          models.EventReviewerReassigned,
+			PullRequestID: "pr-1",
+			UserID:        "user-3",
+			OldUserID:     "user-1",
+			Reason:        models.ReasonUserDeactivated,
+		}}).Return(nil)
+		expectOutbox(ctx, mockUOW, models.TopicReviewerReassigned)
+
+		result, report, err := service.SetIsActive(ctx, "user-1", false)
+		require.NoError(t, err)
+		assert.Equal(t, user, result)
+		assert.Equal(t, []models.ReviewReassignment{
+			{PullRequestID: "pr-1", OldReviewerID: "user-1", NewReviewerID: "user-3"},
+		}, report.Reassigned)
+		assert.Equal(t, []models.ReviewReassignment{
+			{PullRequestID: "pr-2", OldReviewerID: "user-1"},
+		}, report.NotReassigned)
+		mockUOW.AssertExpectations(t)
+		mockPR.AssertExpectations(t)
+		mockEvents.AssertExpectations(t)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockUsers := &mocks.MockUserRepository{}
+
+		service := NewUserService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Rollback").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Users").Return(mockUsers)
+
+		mockUsers.On("SetIsActive", ctx, "missing", false).Return(models.User{}, models.ErrUserNotFound)
+
+		result, _, err := service.SetIsActive(ctx, "missing", false)
+		assert.Equal(t, models.ErrUserNotFound, err)
+		assert.Equal(t, models.User{}, result)
+		mockUOW.AssertExpectations(t)
 	})
 
 	t.Run("empty user id", func(t *testing.T) {
@@ -50,13 +150,265 @@ func TestUserService_SetIsActive(t *testing.T) {
 		mockUOW.On("Close").Return(nil)
 		mockUOW.On("Users").Return(mockUsers)
 
-		result, err := service.SetIsActive(ctx, "", true)
+		result, _, err := service.SetIsActive(ctx, "", true)
 		require.Error(t, err)
 		assert.Equal(t, models.ErrEmptyUserID, err)
 		assert.Equal(t, models.User{}, result)
 	})
 }
 
+func TestUserService_SetMaxOpenReviews(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("limit is set", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockUsers := &mocks.MockUserRepository{}
+
+		service := NewUserService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		limit := 3
+		user := models.User{ID: "user-1", IsActive: true, MaxOpenReviews: &limit}
+
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUsers.On("SetMaxOpenReviews", ctx, "user-1", &limit).Return(user, nil)
+
+		result, err := service.SetMaxOpenReviews(ctx, "user-1", &limit)
+
+		require.NoError(t, err)
+		assert.Equal(t, user, result)
+	})
+
+	t.Run("limit is not positive", func(t *testing.T) {
+		service := NewUserService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			t.Fatal("uow should not be created for invalid limit")
+			return nil, nil
+		})
+
+		limit := 0
+		_, err := service.SetMaxOpenReviews(ctx, "user-1", &limit)
+
+		assert.Equal(t, models.ErrInvalidMaxOpenReviews, err)
+	})
+}
+
+func TestUserService_SetWorkingHours(t *testing.T) {
+	ctx := context.Background()
+	hours := &models.WorkingHours{Start: "09:00", End: "18:00"}
+
+	t.Run("working hours are set", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockUsers := &mocks.MockUserRepository{}
+
+		service := NewUserService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		user := models.User{ID: "user-1", Timezone: "Europe/Moscow", WorkingHours: hours}
+
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUsers.On("SetWorkingHours", ctx, "user-1", "Europe/Moscow", hours).Return(user, nil)
+
+		result, err := service.SetWorkingHours(ctx, "user-1", "Europe/Moscow", hours)
+
+		require.NoError(t, err)
+		assert.Equal(t, user, result)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			timezone string
+			hours    *models.WorkingHours
+			expected error
+		}{
+			{"unknown timezone", "Mars/Olympus", hours, models.ErrInvalidTimezone},
+			{"invalid hours", "Europe/Moscow", &models.WorkingHours{Start: "09:00", End: "25:00"}, models.ErrInvalidWorkingHours},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				service := NewUserService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+					t.Fatal("uow should not be created for invalid input")
+					return nil, nil
+				})
+
+				_, err := service.SetWorkingHours(ctx, "user-1", tt.timezone, tt.hours)
+
+				assert.Equal(t, tt.expected, err)
+			})
+		}
+	})
+}
+
+func TestUserService_SetAbsence(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	absences := []models.Absence{
+		{StartsAt: start, EndsAt: start.Add(14 * 24 * time.Hour)},
+	}
+
+	t.Run("absences are replaced", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockUsers := &mocks.MockUserRepository{}
+
+		service := NewUserService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		stored := []models.Absence{{ID: 1, UserID: "user-1", StartsAt: absences[0].StartsAt, EndsAt: absences[0].EndsAt}}
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Commit").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUsers.On("ReplaceAbsences", ctx, "user-1", absences).Return(stored, nil)
+
+		result, err := service.SetAbsence(ctx, "user-1", absences)
+
+		require.NoError(t, err)
+		assert.Equal(t, stored, result)
+		mockUOW.AssertExpectations(t)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		mockUOW := &mocks.MockUnitOfWork{}
+		mockUsers := &mocks.MockUserRepository{}
+
+		service := NewUserService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			return mockUOW, nil
+		})
+
+		mockUOW.On("Begin", ctx).Return(nil)
+		mockUOW.On("Rollback").Return(nil)
+		mockUOW.On("Close").Return(nil)
+		mockUOW.On("Users").Return(mockUsers)
+		mockUsers.On("ReplaceAbsences", ctx, "missing", absences).Return([]models.Absence(nil), models.ErrUserNotFound)
+
+		_, err := service.SetAbsence(ctx, "missing", absences)
+
+		assert.Equal(t, models.ErrUserNotFound, err)
+		mockUOW.AssertExpectations(t)
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		service := NewUserService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			t.Fatal("uow should not be created for invalid absence")
+			return nil, nil
+		})
+
+		_, err := service.SetAbsence(ctx, "user-1", []models.Absence{{StartsAt: start, EndsAt: start}})
+
+		assert.Equal(t, models.ErrInvalidAbsence, err)
+	})
+
+	t.Run("too many absences", func(t *testing.T) {
+		service := NewUserService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			t.Fatal("uow should not be created for too many absences")
+			return nil, nil
+		})
+
+		_, err := service.SetAbsence(ctx, "user-1", make([]models.Absence, models.MaxAbsencesCount+1))
+
+		assert.Equal(t, models.ErrTooManyAbsences, err)
+	})
+}
+
+func TestUserService_ReassignAbsentReviews(t *testing.T) {
+	ctx := context.Background()
+
+	mockUOW := &mocks.MockUnitOfWork{}
+	mockUsers := &mocks.MockUserRepository{}
+	mockPR := &mocks.MockPRRepository{}
+	mockTeams := &mocks.MockTeamRepository{}
+	mockEvents := &mocks.MockEventRepository{}
+
+	service := NewUserService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+		return mockUOW, nil
+	})
+
+	user := models.User{ID: "user-1", IsActive: true, TeamName: "team-1"}
+	teammates := []models.User{{ID: "user-3", IsActive: true, TeamName: "team-1"}}
+
+	mockUOW.On("Begin", ctx).Return(nil)
+	mockUOW.On("Commit").Return(nil)
+	mockUOW.On("Close").Return(nil)
+	mockUOW.On("Users").Return(mockUsers)
+	mockUOW.On("PR").Return(mockPR)
+	mockUOW.On("Teams").Return(mockTeams)
+	mockUOW.On("Events").Return(mockEvents)
+
+	mockUsers.On("GetStartedAbsences", ctx, 1).Return([]models.Absence{{ID: 5, UserID: "user-1"}}, nil).Once()
+	mockUsers.On("GetStartedAbsences", ctx, 1).Return([]models.Absence{}, nil).Once()
+	mockUsers.On("GetByID", ctx, "user-1").Return(user, nil)
+	mockPR.On("GetOpenByReviewers", ctx, []string{"user-1"}).Return([]models.PullRequest{
+		{ID: "pr-1", AuthorID: "user-2", Status: models.PRStatusOpen, AssignedReviewers: []string{"user-1"}},
+	}, nil)
+	mockUsers.On("GetActiveTeammatesByUserID", ctx, "user-1").Return(teammates, nil)
+	mockTeams.On("GetSettings", ctx, "team-1").Return(models.DefaultTeamSettings(), nil)
+	mockPR.On("CountOpenReviews", ctx, []string{"user-3"}).Return(map[string]int{"user-3": 0}, nil)
+	mockPR.On("Reassign", ctx, "pr-1", "user-1", "user-3").Return(models.PullRequest{ID: "pr-1"}, nil)
+	mockEvents.On("Append", ctx, []models.AssignmentEvent{{
+		Type:          models.EventReviewerReassigned,
+		PullRequestID: "pr-1",
+		UserID:        "user-3",
+		OldUserID:     "user-1",
+		Reason:        models.ReasonUserAbsent,
+	}}).Return(nil)
+	expectOutbox(ctx, mockUOW, models.TopicReviewerReassigned)
+	mockUsers.On("MarkAbsenceReassigned", ctx, int64(5)).Return(nil).Once()
+
+	n, err := service.ReassignAbsentReviews(ctx, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	mockUOW.AssertExpectations(t)
+	mockUsers.AssertExpectations(t)
+	mockPR.AssertExpectations(t)
+	mockEvents.AssertExpectations(t)
+}
+
+func TestUserService_ReassignAbsentReviews_SkipsFailed(t *testing.T) {
+	ctx := context.Background()
+
+	mockUOW := &mocks.MockUnitOfWork{}
+	mockUsers := &mocks.MockUserRepository{}
+	mockPR := &mocks.MockPRRepository{}
+
+	service := NewUserService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+		return mockUOW, nil
+	})
+
+	failed := models.Absence{ID: 5, UserID: "user-1"}
+	next := models.Absence{ID: 6, UserID: "user-2"}
+
+	mockUOW.On("Begin", ctx).Return(nil)
+	mockUOW.On("Rollback").Return(nil).Once()
+	mockUOW.On("Commit").Return(nil).Twice()
+	mockUOW.On("Close").Return(nil)
+	mockUOW.On("Users").Return(mockUsers)
+	mockUOW.On("PR").Return(mockPR)
+
+	mockUsers.On("GetStartedAbsences", ctx, 1).Return([]models.Absence{failed}, nil).Once()
+	mockUsers.On("GetByID", ctx, "user-1").Return(models.User{}, errors.New("db is down")).Once()
+	mockUsers.On("GetStartedAbsences", ctx, 2).Return([]models.Absence{failed, next}, nil).Once()
+	mockUsers.On("GetByID", ctx, "user-2").Return(models.User{ID: "user-2", TeamName: "team-1"}, nil)
+	mockPR.On("GetOpenByReviewers", ctx, []string{"user-2"}).Return([]models.PullRequest{}, nil)
+	mockUsers.On("MarkAbsenceReassigned", ctx, int64(6)).Return(nil).Once()
+	mockUsers.On("GetStartedAbsences", ctx, 2).Return([]models.Absence{failed}, nil).Once()
+
+	n, err := service.ReassignAbsentReviews(ctx, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	mockUsers.AssertNotCalled(t, "MarkAbsenceReassigned", ctx, int64(5))
+	mockUOW.AssertExpectations(t)
+	mockUsers.AssertExpectations(t)
+}
+
 func TestUserService_GetPRs(t *testing.T) {
 	ctx := context.Background()
 
@@ -69,25 +421,33 @@ func TestUserService_GetPRs(t *testing.T) {
 			return mockUOW, nil
 		})
 
-		prs := []models.PullRequest{
-			{
-				ID:                "pr-1",
-				Name:              "PR 1",
-				AuthorID:          "author",
-				Status:            models.PRStatusOpen,
-				AssignedReviewers: []string{"user-1", "user-2"},
+		page := models.PRPage{
+			PullRequests: []models.PullRequest{
+				{
+					ID:                "pr-1",
+					Name:              "PR 1",
+					AuthorID:          "author",
+					Status:            models.PRStatusOpen,
+					AssignedReviewers: []string{"user-1", "user-2"},
+				},
 			},
+			NextCursor: "next",
 		}
+		filter := models.PRFilter{Status: models.PRStatusOpen, Limit: 1}
+		countFilter := filter
+		countFilter.ReviewerID = "user-1"
 
 		mockUOW.On("Close").Return(nil)
 		mockUOW.On("PR").Return(mockPR)
 		mockUOW.On("Users").Return(mockUsers)
 
-		mockPR.On("GetPRs", ctx, "user-1").Return(prs, nil)
+		mockPR.On("GetPRs", ctx, "user-1", filter).Return(page, nil)
+		mockPR.On("CountPRs", ctx, countFilter).Return(3, nil)
 
-		result, err := service.GetPRs(ctx, "user-1")
+		result, total, err := service.GetPRs(ctx, "user-1", filter)
 		require.NoError(t, err)
-		assert.Equal(t, prs, result)
+		assert.Equal(t, page, result)
+		assert.Equal(t, 3, total)
 	})
 
 	t.Run("empty user id", func(t *testing.T) {
@@ -99,8 +459,18 @@ func TestUserService_GetPRs(t *testing.T) {
 
 		mockUOW.On("Close").Return(nil)
 
-		result, err := service.GetPRs(ctx, "")
+		result, _, err := service.GetPRs(ctx, "", models.PRFilter{})
 		require.Error(t, models.ErrEmptyUserID, err)
-		assert.Equal(t, []models.PullRequest{}, result)
+		assert.Equal(t, models.PRPage{}, result)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		service := NewUserService(func(ctx context.Context) (repositories.UnitOfWork, error) {
+			t.Fatal("unit of work must not be created")
+			return nil, nil
+		})
+
+		_, _, err := service.GetPRs(ctx, "user-1", models.PRFilter{Status: "REVIEWED"})
+		assert.Equal(t, models.ErrInvalidPRStatus, err)
 	})
 }
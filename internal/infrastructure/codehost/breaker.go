@@ -0,0 +1,78 @@
+package codehost
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+)
+
+// ErrCircuitOpen postpones delivery, the code host was not called
+var ErrCircuitOpen = fmt.Errorf("%w: code host circuit is open", models.ErrDeliveryPostponed)
+
+type breakerState int
+
+const (
+	stateClosed breakerState = iota
+	stateOpen
+	stateHalfOpen
+)
+
+// Breaker stops calls to the code host after threshold consecutive failures,
+// after cooldown one trial call is let through to probe it
+type Breaker struct {
+	mu        sync.Mutex
+	state     breakerState
+	failures  int
+	openedAt  time.Time
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+}
+
+func NewBreaker(threshold int, cooldown time.Duration) *Breaker {
+	return &Breaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// Allow returns ErrCircuitOpen when the call must not be made
+func (b *Breaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case stateOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return ErrCircuitOpen
+		}
+		b.state = stateHalfOpen
+		return nil
+	case stateHalfOpen:
+		// trial call is in flight
+		return ErrCircuitOpen
+	default:
+		return nil
+	}
+}
+
+// Record reports outcome of the allowed call
+func (b *Breaker) Record(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if success {
+		b.state = stateClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == stateHalfOpen || b.failures >= b.threshold {
+		b.state = stateOpen
+		b.openedAt = b.now()
+	}
+}
@@ -0,0 +1,33 @@
+package codehost
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreaker(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	breaker := NewBreaker(2, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	assert.NoError(t, breaker.Allow())
+	breaker.Record(false)
+	assert.NoError(t, breaker.Allow(), "below threshold")
+	breaker.Record(false)
+	assert.ErrorIs(t, breaker.Allow(), ErrCircuitOpen)
+
+	now = now.Add(time.Minute)
+	assert.NoError(t, breaker.Allow(), "trial call after cooldown")
+	assert.ErrorIs(t, breaker.Allow(), ErrCircuitOpen, "only one trial call")
+	breaker.Record(false)
+	assert.ErrorIs(t, breaker.Allow(), ErrCircuitOpen, "failed trial reopens")
+
+	now = now.Add(time.Minute)
+	assert.NoError(t, breaker.Allow())
+	breaker.Record(true)
+	assert.NoError(t, breaker.Allow())
+	breaker.Record(false)
+	assert.NoError(t, breaker.Allow(), "failures are counted from zero after recovery")
+}
@@ -0,0 +1,145 @@
+package codehost
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+)
+
+type GitHubConfig struct {
+	// BaseURL of the REST API, e.g. "https://api.github.com"
+	BaseURL string
+	Token   string
+	Timeout time.Duration
+	// MaxRetries is the number of retries of a transient failure within one call
+	MaxRetries       int
+	RetryBackoff     time.Duration
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+}
+
+func DefaultGitHubConfig(baseURL, token string) GitHubConfig {
+	return GitHubConfig{
+		BaseURL:          baseURL,
+		Token:            token,
+		Timeout:          10 * time.Second,
+		MaxRetries:       2,
+		RetryBackoff:     500 * time.Millisecond,
+		BreakerThreshold: 5,
+		BreakerCooldown:  time.Minute,
+	}
+}
+
+// GitHubClient requests and removes reviewers of pull requests via GitHub REST API
+type GitHubClient struct {
+	client  *http.Client
+	cfg     GitHubConfig
+	breaker *Breaker
+}
+
+func NewGitHubClient(cfg GitHubConfig) *GitHubClient {
+	return &GitHubClient{
+		client:  &http.Client{Timeout: cfg.Timeout},
+		cfg:     cfg,
+		breaker: NewBreaker(cfg.BreakerThreshold, cfg.BreakerCooldown),
+	}
+}
+
+type requestedReviewers struct {
+	Reviewers []string `json:"reviewers"`
+}
+
+func (c *GitHubClient) RequestReviewers(ctx context.Context, pr models.CodeHostPR, reviewers []string) error {
+	return c.call(ctx, http.MethodPost, pr, reviewers)
+}
+
+func (c *GitHubClient) RemoveReviewers(ctx context.Context, pr models.CodeHostPR, reviewers []string) error {
+	return c.call(ctx, http.MethodDelete, pr, reviewers)
+}
+
+// call retries transient failures, errors of the request itself wrap models.ErrPermanentDelivery
+func (c *GitHubClient) call(ctx context.Context, method string, pr models.CodeHostPR, reviewers []string) error {
+	body, err := json.Marshal(requestedReviewers{Reviewers: reviewers})
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/repos/%s/pulls/%d/requested_reviewers",
+		strings.TrimRight(c.cfg.BaseURL, "/"), pr.Repository, pr.Number)
+
+	delay := c.cfg.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		if err := c.breaker.Allow(); err != nil {
+			return err
+		}
+
+		err = c.do(ctx, method, url, body)
+		var transient *transientError
+		c.breaker.Record(!errors.As(err, &transient))
+		if err == nil || transient == nil || attempt >= c.cfg.MaxRetries {
+			return err
+		}
+
+		slog.Warn("github request failed, retrying",
+			"method", method,
+			"url", url,
+			"attempt", attempt+1,
+			"error", err.Error(),
+		)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// transientError is a failure that may succeed on retry
+type transientError struct {
+	err error
+}
+
+func (e *transientError) Error() string { return e.err.Error() }
+func (e *transientError) Unwrap() error { return e.err }
+
+func (c *GitHubClient) do(ctx context.Context, method, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if c.cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.cfg.Token)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return &transientError{err: err}
+	}
+	defer res.Body.Close()
+	message, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		return nil
+	case res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500,
+		res.StatusCode == http.StatusForbidden && res.Header.Get("X-RateLimit-Remaining") == "0":
+		return &transientError{err: fmt.Errorf("github responded %d: %s", res.StatusCode, message)}
+	default:
+		// e.g. reviewer is not a collaborator, retrying won't help
+		return fmt.Errorf("%w: github responded %d: %s", models.ErrPermanentDelivery, res.StatusCode, message)
+	}
+}
@@ -0,0 +1,120 @@
+package codehost
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type githubRequest struct {
+	method        string
+	path          string
+	authorization string
+	reviewers     []string
+}
+
+// fakeGitHub records requests and answers with queued statuses, 201 when the queue is empty
+type fakeGitHub struct {
+	mu       sync.Mutex
+	requests []githubRequest
+	statuses []int
+}
+
+func (f *fakeGitHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body requestedReviewers
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, githubRequest{
+		method:        r.Method,
+		path:          r.URL.Path,
+		authorization: r.Header.Get("Authorization"),
+		reviewers:     body.Reviewers,
+	})
+
+	status := http.StatusCreated
+	if len(f.statuses) > 0 {
+		status, f.statuses = f.statuses[0], f.statuses[1:]
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(`{"message":"fake"}`))
+}
+
+func newTestClient(t *testing.T, fake *fakeGitHub) *GitHubClient {
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	cfg := DefaultGitHubConfig(server.URL, "token")
+	cfg.RetryBackoff = time.Millisecond
+	cfg.BreakerThreshold = 3
+	return NewGitHubClient(cfg)
+}
+
+var testPR = models.CodeHostPR{Repository: "octo/app", Number: 42}
+
+func TestGitHubClient_RequestAndRemoveReviewers(t *testing.T) {
+	fake := &fakeGitHub{}
+	client := newTestClient(t, fake)
+
+	require.NoError(t, client.RequestReviewers(context.Background(), testPR, []string{"alice"}))
+	require.NoError(t, client.RemoveReviewers(context.Background(), testPR, []string{"bob"}))
+
+	assert.Equal(t, []githubRequest{
+		{http.MethodPost, "/repos/octo/app/pulls/42/requested_reviewers", "Bearer token", []string{"alice"}},
+		{http.MethodDelete, "/repos/octo/app/pulls/42/requested_reviewers", "Bearer token", []string{"bob"}},
+	}, fake.requests)
+}
+
+func TestGitHubClient_RetriesTransientFailures(t *testing.T) {
+	fake := &fakeGitHub{statuses: []int{http.StatusBadGateway, http.StatusTooManyRequests}}
+	client := newTestClient(t, fake)
+
+	err := client.RequestReviewers(context.Background(), testPR, []string{"alice"})
+
+	require.NoError(t, err)
+	assert.Len(t, fake.requests, 3)
+}
+
+func TestGitHubClient_GivesUpAfterRetries(t *testing.T) {
+	fake := &fakeGitHub{statuses: []int{500, 500, 500, 500}}
+	client := newTestClient(t, fake)
+
+	err := client.RequestReviewers(context.Background(), testPR, []string{"alice"})
+
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, models.ErrPermanentDelivery)
+	assert.Len(t, fake.requests, 3)
+}
+
+func TestGitHubClient_ClientErrorIsPermanent(t *testing.T) {
+	fake := &fakeGitHub{statuses: []int{http.StatusUnprocessableEntity}}
+	client := newTestClient(t, fake)
+
+	err := client.RequestReviewers(context.Background(), testPR, []string{"stranger"})
+
+	assert.ErrorIs(t, err, models.ErrPermanentDelivery)
+	assert.Len(t, fake.requests, 1)
+}
+
+func TestGitHubClient_CircuitOpensOnOutage(t *testing.T) {
+	fake := &fakeGitHub{statuses: []int{503, 503, 503}}
+	client := newTestClient(t, fake)
+
+	err := client.RequestReviewers(context.Background(), testPR, []string{"alice"})
+	require.Error(t, err)
+
+	// github is not called while the circuit is open
+	err = client.RequestReviewers(context.Background(), testPR, []string{"alice"})
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.ErrorIs(t, err, models.ErrDeliveryPostponed)
+	assert.Len(t, fake.requests, 3)
+}
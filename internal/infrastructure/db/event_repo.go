@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/437d5/pr-review-manager/internal/infrastructure/dto"
+	"github.com/jmoiron/sqlx"
+)
+
+type EventRepository struct {
+	db sqlx.ExtContext
+}
+
+func NewEventRepository(db sqlx.ExtContext) *EventRepository {
+	return &EventRepository{db: db}
+}
+
+// Append writes events to the audit log in the given order with batched inserts
+func (r *EventRepository) Append(ctx context.Context, events []models.AssignmentEvent) error {
+	const (
+		batchSize = 1000
+		columns   = 6
+	)
+
+	for start := 0; start < len(events); start += batchSize {
+		end := min(start+batchSize, len(events))
+		batch := events[start:end]
+
+		values := make([]string, len(batch))
+		args := make([]any, 0, len(batch)*columns)
+		for i, event := range batch {
+			n := i * columns
+			values[i] = fmt.Sprintf("($%d::assignment_event_type, $%d, $%d, $%d, $%d, $%d)", n+1, n+2, n+3, n+4, n+5, n+6)
+			args = append(args,
+				string(event.Type),
+				dto.NullString(event.PullRequestID),
+				dto.NullString(event.UserID),
+				dto.NullString(event.OldUserID),
+				dto.NullString(event.Reason),
+				dto.NullString(event.ActorID),
+			)
+		}
+
+		query := `
+			INSERT INTO assignment_events (event_type, pull_request_id, user_id, old_user_id, reason, actor_id)
+			VALUES ` + strings.Join(values, ", ")
+
+		if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+			slog.Error("cannot append assignment events", "error", err, "batch_size", len(batch))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetByPR returns events of the PR, oldest first
+func (r *EventRepository) GetByPR(ctx context.Context, prID string) ([]models.AssignmentEvent, error) {
+	const query = `
+		SELECT id, event_type, pull_request_id, user_id, old_user_id, reason, actor_id, created_at
+		FROM assignment_events
+		WHERE pull_request_id = $1
+		ORDER BY id
+	`
+
+	var eventDTOs []dto.AssignmentEvent
+	if err := sqlx.SelectContext(ctx, r.db, &eventDTOs, query, prID); err != nil {
+		slog.Error("cannot get assignment events", "error", err, "pr_id", prID)
+		return nil, err
+	}
+
+	events := make([]models.AssignmentEvent, len(eventDTOs))
+	for i, eventDTO := range eventDTOs {
+		events[i] = eventDTO.ToDomain()
+	}
+
+	return events, nil
+}
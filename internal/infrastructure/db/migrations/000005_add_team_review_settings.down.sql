@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_pr_reviewers_reviewer_assigned_at;
+
+ALTER TABLE teams
+    DROP CONSTRAINT IF EXISTS check_reviewers_count_valid,
+    DROP CONSTRAINT IF EXISTS check_review_strategy_valid,
+    DROP COLUMN IF EXISTS reviewers_count,
+    DROP COLUMN IF EXISTS review_strategy;
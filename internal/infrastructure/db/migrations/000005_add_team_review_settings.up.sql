@@ -0,0 +1,11 @@
+ALTER TABLE teams
+    ADD COLUMN review_strategy VARCHAR(32) NOT NULL DEFAULT 'LEAST_LOADED',
+    ADD COLUMN reviewers_count INTEGER NOT NULL DEFAULT 2,
+    ADD CONSTRAINT check_review_strategy_valid CHECK (
+        review_strategy IN ('RANDOM', 'ROUND_ROBIN', 'LEAST_LOADED', 'WEIGHTED')
+    ),
+    ADD CONSTRAINT check_reviewers_count_valid CHECK (
+        reviewers_count BETWEEN 1 AND 10
+    );
+
+CREATE INDEX idx_pr_reviewers_reviewer_assigned_at ON pull_requests_reviewers (reviewer_id, assigned_at);
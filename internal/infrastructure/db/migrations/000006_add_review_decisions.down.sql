@@ -0,0 +1,6 @@
+ALTER TABLE pull_requests_reviewers
+    DROP CONSTRAINT IF EXISTS check_decided_at_valid,
+    DROP COLUMN IF EXISTS decided_at,
+    DROP COLUMN IF EXISTS decision;
+
+DROP TYPE IF EXISTS review_decision;
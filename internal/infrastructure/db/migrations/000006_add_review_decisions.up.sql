@@ -0,0 +1,9 @@
+CREATE TYPE review_decision AS ENUM ('APPROVED', 'CHANGES_REQUESTED', 'COMMENTED');
+
+ALTER TABLE pull_requests_reviewers
+    ADD COLUMN decision review_decision NULL,
+    ADD COLUMN decided_at TIMESTAMP WITH TIME ZONE NULL,
+    ADD CONSTRAINT check_decided_at_valid CHECK (
+        (decision IS NULL AND decided_at IS NULL) OR
+        (decision IS NOT NULL AND decided_at IS NOT NULL)
+    );
@@ -0,0 +1,7 @@
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS force_merged;
+
+ALTER TABLE teams
+    DROP CONSTRAINT IF EXISTS check_required_approvals_valid,
+    DROP COLUMN IF EXISTS block_on_changes_requested,
+    DROP COLUMN IF EXISTS required_approvals;
@@ -0,0 +1,9 @@
+ALTER TABLE teams
+    ADD COLUMN required_approvals INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN block_on_changes_requested BOOLEAN NOT NULL DEFAULT false,
+    ADD CONSTRAINT check_required_approvals_valid CHECK (
+        required_approvals BETWEEN 0 AND reviewers_count
+    );
+
+ALTER TABLE pull_requests
+    ADD COLUMN force_merged BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,19 @@
+ALTER TABLE pull_requests DROP CONSTRAINT check_merged_at_valid;
+ALTER TABLE pull_requests ALTER COLUMN status DROP DEFAULT;
+
+UPDATE pull_requests SET status = 'OPEN' WHERE status IN ('DRAFT', 'CLOSED');
+
+ALTER TYPE pr_status RENAME TO pr_status_old;
+CREATE TYPE pr_status AS ENUM ('OPEN', 'MERGED');
+
+ALTER TABLE pull_requests
+    ALTER COLUMN status TYPE pr_status USING status::text::pr_status,
+    ALTER COLUMN status SET DEFAULT 'OPEN';
+
+DROP TYPE pr_status_old;
+
+ALTER TABLE pull_requests
+    ADD CONSTRAINT check_merged_at_valid CHECK (
+        (status = 'MERGED' AND merged_at IS NOT NULL) OR
+        (status = 'OPEN' AND merged_at IS NULL)
+    );
@@ -0,0 +1 @@
+CREATE INDEX idx_pull_requests_created_at_id ON pull_requests (created_at DESC, id DESC);
@@ -0,0 +1,11 @@
+CREATE TABLE reviewer_reassignments (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    old_reviewer_id VARCHAR(255) NOT NULL REFERENCES users(id),
+    new_reviewer_id VARCHAR(255) NOT NULL REFERENCES users(id),
+    -- when the replaced assignment was made
+    assigned_at TIMESTAMP WITH TIME ZONE,
+    reassigned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_reviewer_reassignments_old_reviewer ON reviewer_reassignments (old_reviewer_id, assigned_at);
@@ -0,0 +1,32 @@
+CREATE TYPE assignment_event_type AS ENUM (
+    'PR_CREATED',
+    'REVIEWER_ASSIGNED',
+    'REVIEWER_REASSIGNED',
+    'PR_MERGED',
+    'USER_ACTIVATED',
+    'USER_DEACTIVATED'
+);
+
+CREATE TABLE assignment_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_type assignment_event_type NOT NULL,
+    pull_request_id VARCHAR(255) REFERENCES pull_requests(id),
+    -- assigned reviewer, new reviewer on reassignment or (de)activated user
+    user_id VARCHAR(255) REFERENCES users(id),
+    old_user_id VARCHAR(255) REFERENCES users(id),
+    reason TEXT,
+    actor_id VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_assignment_events_pull_request ON assignment_events (pull_request_id, id);
+
+CREATE FUNCTION reject_assignment_events_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'assignment_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER assignment_events_append_only
+    BEFORE UPDATE OR DELETE ON assignment_events
+    FOR EACH STATEMENT EXECUTE FUNCTION reject_assignment_events_change();
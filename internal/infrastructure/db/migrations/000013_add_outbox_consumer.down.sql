@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_outbox_pending;
+DELETE FROM outbox WHERE consumer <> 'webhooks';
+ALTER TABLE outbox DROP COLUMN IF EXISTS consumer;
+CREATE INDEX idx_outbox_pending ON outbox (next_attempt_at, id) WHERE status = 'PENDING';
@@ -0,0 +1,5 @@
+ALTER TABLE outbox ADD COLUMN consumer VARCHAR(64) NOT NULL DEFAULT 'webhooks';
+ALTER TABLE outbox ALTER COLUMN consumer DROP DEFAULT;
+
+DROP INDEX IF EXISTS idx_outbox_pending;
+CREATE INDEX idx_outbox_pending ON outbox (consumer, next_attempt_at, id) WHERE status = 'PENDING';
@@ -0,0 +1,18 @@
+-- CODEOWNERS rules of a team, for a path the matching rule with the greatest line wins
+CREATE TABLE team_ownership_rules (
+    team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    line INTEGER NOT NULL,
+    pattern TEXT NOT NULL,
+    -- user ids separated by spaces
+    owners TEXT NOT NULL DEFAULT '',
+
+    PRIMARY KEY (team_id, line)
+);
+
+-- paths changed by PR, reviewers of a draft are selected by them when it is marked ready
+CREATE TABLE pull_request_files (
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    path TEXT NOT NULL,
+
+    PRIMARY KEY (pull_request_id, path)
+);
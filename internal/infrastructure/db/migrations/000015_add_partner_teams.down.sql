@@ -0,0 +1,3 @@
+ALTER TABLE pull_requests_reviewers DROP COLUMN IF EXISTS is_fallback;
+
+DROP TABLE IF EXISTS team_partners;
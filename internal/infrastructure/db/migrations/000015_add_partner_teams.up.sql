@@ -0,0 +1,11 @@
+-- reviewers of team_id PRs are drawn from partner_team_id when the team is short of them
+CREATE TABLE team_partners (
+    team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    partner_team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+
+    PRIMARY KEY (team_id, partner_team_id),
+    CONSTRAINT check_partner_is_other_team CHECK (team_id <> partner_team_id)
+);
+
+ALTER TABLE pull_requests_reviewers
+    ADD COLUMN is_fallback BOOLEAN NOT NULL DEFAULT false;
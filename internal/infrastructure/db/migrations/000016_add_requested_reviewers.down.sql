@@ -0,0 +1 @@
+ALTER TABLE pull_requests_reviewers DROP COLUMN IF EXISTS is_requested;
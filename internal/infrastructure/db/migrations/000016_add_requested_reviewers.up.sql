@@ -0,0 +1,3 @@
+-- reviewer explicitly requested by the author, replaced only on force
+ALTER TABLE pull_requests_reviewers
+    ADD COLUMN is_requested BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,3 @@
+-- postgres cannot drop a value of enum type and assignment_events is append-only,
+-- REVIEWER_REMOVED stays in assignment_event_type
+SELECT 1;
@@ -0,0 +1 @@
+ALTER TYPE assignment_event_type ADD VALUE IF NOT EXISTS 'REVIEWER_REMOVED';
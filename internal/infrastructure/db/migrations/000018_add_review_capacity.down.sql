@@ -0,0 +1,7 @@
+ALTER TABLE teams
+    DROP CONSTRAINT IF EXISTS check_overflow_policy_valid,
+    DROP COLUMN IF EXISTS overflow_policy;
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS check_max_open_reviews_positive,
+    DROP COLUMN IF EXISTS max_open_reviews;
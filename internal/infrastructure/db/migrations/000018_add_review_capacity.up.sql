@@ -0,0 +1,9 @@
+ALTER TABLE users
+    ADD COLUMN max_open_reviews INTEGER,
+    ADD CONSTRAINT check_max_open_reviews_positive CHECK (max_open_reviews > 0);
+
+ALTER TABLE teams
+    ADD COLUMN overflow_policy VARCHAR(32) NOT NULL DEFAULT 'ASSIGN_ANYWAY',
+    ADD CONSTRAINT check_overflow_policy_valid CHECK (
+        overflow_policy IN ('ASSIGN_ANYWAY', 'ASSIGN_FEWER', 'REJECT')
+    );
@@ -0,0 +1,19 @@
+CREATE TABLE user_absences (
+    id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL,
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    -- set when open reviews of the user were moved away after the absence started
+    reassigned_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_absences_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT check_absence_range CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX idx_user_absences_user_id ON user_absences (user_id, ends_at);
+CREATE INDEX idx_user_absences_pending ON user_absences (starts_at) WHERE reassigned_at IS NULL;
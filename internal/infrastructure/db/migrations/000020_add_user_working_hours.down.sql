@@ -0,0 +1,5 @@
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS check_working_hours_valid,
+    DROP COLUMN IF EXISTS work_end,
+    DROP COLUMN IF EXISTS work_start,
+    DROP COLUMN IF EXISTS timezone;
@@ -0,0 +1,14 @@
+-- working hours are minutes since midnight in the user's timezone,
+-- work_end before work_start means that work ends on the next day
+ALTER TABLE users
+    ADD COLUMN timezone VARCHAR(64),
+    ADD COLUMN work_start SMALLINT,
+    ADD COLUMN work_end SMALLINT,
+    ADD CONSTRAINT check_working_hours_valid CHECK (
+        (work_start IS NULL AND work_end IS NULL)
+        OR (
+            work_start BETWEEN 0 AND 1439
+            AND work_end BETWEEN 0 AND 1439
+            AND work_start <> work_end
+        )
+    );
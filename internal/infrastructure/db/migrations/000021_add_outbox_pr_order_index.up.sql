@@ -0,0 +1,2 @@
+-- messages of a PR are delivered in order, a message waits while an older one of the same PR is pending
+CREATE INDEX idx_outbox_pending_pr ON outbox (consumer, (payload->>'pull_request_id'), id) WHERE status = 'PENDING';
@@ -0,0 +1,2 @@
+-- targets that accepted a message, they are skipped when the message is retried
+ALTER TABLE outbox ADD COLUMN delivered_targets JSONB NOT NULL DEFAULT '[]';
@@ -0,0 +1,17 @@
+-- reviewers requested by the author of a draft, they are assigned when it is marked ready
+CREATE TABLE draft_requested_reviewers (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(255) NOT NULL,
+    reviewer_id VARCHAR(255) NOT NULL,
+
+    CONSTRAINT fk_draft_requested_pull_request
+        FOREIGN KEY (pull_request_id)
+        REFERENCES pull_requests(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_draft_requested_user
+        FOREIGN KEY (reviewer_id)
+        REFERENCES users(id),
+
+    CONSTRAINT unique_draft_requested_reviewers UNIQUE (pull_request_id, reviewer_id)
+);
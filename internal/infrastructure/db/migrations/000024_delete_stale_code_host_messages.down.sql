@@ -0,0 +1,2 @@
+-- deleted messages are not restored
+SELECT 1;
@@ -0,0 +1,6 @@
+-- code host messages are put to the outbox only for PRs imported from GitHub,
+-- the pending ones of other PRs would never be delivered
+DELETE FROM outbox
+WHERE consumer = 'code_host'
+  AND status = 'PENDING'
+  AND payload->>'pull_request_id' !~ '^[^/]+/[^/]+#0*[1-9][0-9]*$';
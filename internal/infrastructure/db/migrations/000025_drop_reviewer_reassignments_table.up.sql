@@ -0,0 +1,5 @@
+-- reassignments are taken from REVIEWER_REASSIGNED events of assignment_events
+DROP TABLE IF EXISTS reviewer_reassignments;
+
+CREATE INDEX idx_assignment_events_reassigned ON assignment_events (old_user_id)
+    WHERE event_type = 'REVIEWER_REASSIGNED';
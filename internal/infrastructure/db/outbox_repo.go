@@ -0,0 +1,184 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/437d5/pr-review-manager/internal/infrastructure/dto"
+	"github.com/jmoiron/sqlx"
+)
+
+type OutboxRepository struct {
+	db sqlx.ExtContext
+}
+
+func NewOutboxRepository(db sqlx.ExtContext) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// Add puts messages to the outbox with batched inserts, they are due immediately
+func (r *OutboxRepository) Add(ctx context.Context, messages []models.OutboxMessage) error {
+	const batchSize = 1000
+
+	for start := 0; start < len(messages); start += batchSize {
+		end := min(start+batchSize, len(messages))
+		batch := messages[start:end]
+
+		values := make([]string, len(batch))
+		args := make([]any, 0, len(batch)*3)
+		for i, message := range batch {
+			values[i] = fmt.Sprintf("($%d, $%d, $%d)", i*3+1, i*3+2, i*3+3)
+			args = append(args, message.Consumer, message.Topic, []byte(message.Payload))
+		}
+
+		query := `
+			INSERT INTO outbox (consumer, topic, payload)
+			VALUES ` + strings.Join(values, ", ")
+
+		if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+			slog.Error("cannot add outbox messages", "error", err, "batch_size", len(batch))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Claim leases up to limit pending messages of the consumer that are due, oldest first.
+// A leased message is not due again until the lease expires, so it is published by
+// one dispatcher without holding a transaction, and retried if that one crashes.
+// Messages of a PR keep their order: a message is not due while an older one of the same PR
+// is pending, so a retried assignment is never delivered after a later change of reviewers.
+func (r *OutboxRepository) Claim(ctx context.Context, consumer string, limit int, lease time.Duration) ([]models.OutboxMessage, error) {
+	const query = `
+		WITH claimed AS (
+			UPDATE outbox
+			SET next_attempt_at = CURRENT_TIMESTAMP + $3 * INTERVAL '1 millisecond'
+			WHERE id IN (
+				SELECT o.id
+				FROM outbox o
+				WHERE o.consumer = $1
+					AND o.status = 'PENDING'
+					AND o.next_attempt_at <= CURRENT_TIMESTAMP
+					AND NOT EXISTS (
+						SELECT 1
+						FROM outbox older
+						WHERE older.consumer = o.consumer
+							AND older.status = 'PENDING'
+							AND older.payload->>'pull_request_id' = o.payload->>'pull_request_id'
+							AND older.id < o.id
+					)
+				ORDER BY o.next_attempt_at, o.id
+				LIMIT $2
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, consumer, topic, payload, attempts, delivered_targets
+		)
+		SELECT id, consumer, topic, payload, attempts, delivered_targets
+		FROM claimed
+		ORDER BY id
+	`
+
+	var messageDTOs []dto.OutboxMessage
+	if err := sqlx.SelectContext(ctx, r.db, &messageDTOs, query, consumer, limit, lease.Milliseconds()); err != nil {
+		slog.Error("cannot claim due outbox messages", "error", err, "consumer", consumer)
+		return nil, err
+	}
+
+	messages := make([]models.OutboxMessage, len(messageDTOs))
+	for i, messageDTO := range messageDTOs {
+		messages[i] = messageDTO.ToDomain()
+	}
+
+	return messages, nil
+}
+
+func (r *OutboxRepository) MarkDelivered(ctx context.Context, id int64) error {
+	const query = `
+		UPDATE outbox
+		SET status = 'DELIVERED', attempts = attempts + 1, last_error = NULL, delivered_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		slog.Error("cannot mark outbox message delivered", "error", err, "id", id)
+		return err
+	}
+
+	return nil
+}
+
+// MarkFailed records failed attempt and schedules the next one after delay
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id int64, lastError string, delay time.Duration) error {
+	const query = `
+		UPDATE outbox
+		SET attempts = attempts + 1, last_error = $2,
+			next_attempt_at = CURRENT_TIMESTAMP + $3 * INTERVAL '1 millisecond'
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, lastError, delay.Milliseconds()); err != nil {
+		slog.Error("cannot mark outbox message failed", "error", err, "id", id)
+		return err
+	}
+
+	return nil
+}
+
+// AddDeliveredTargets records targets that accepted the message
+func (r *OutboxRepository) AddDeliveredTargets(ctx context.Context, id int64, targets []string) error {
+	const query = `
+		UPDATE outbox
+		SET delivered_targets = delivered_targets || $2::jsonb
+		WHERE id = $1
+	`
+
+	data, err := json.Marshal(targets)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, id, string(data)); err != nil {
+		slog.Error("cannot add delivered targets of outbox message", "error", err, "id", id)
+		return err
+	}
+
+	return nil
+}
+
+// Postpone schedules the next attempt after delay, the attempt is not counted
+func (r *OutboxRepository) Postpone(ctx context.Context, id int64, lastError string, delay time.Duration) error {
+	const query = `
+		UPDATE outbox
+		SET last_error = $2, next_attempt_at = CURRENT_TIMESTAMP + $3 * INTERVAL '1 millisecond'
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, lastError, delay.Milliseconds()); err != nil {
+		slog.Error("cannot postpone outbox message", "error", err, "id", id)
+		return err
+	}
+
+	return nil
+}
+
+// MarkDead records the last failed attempt and stops retrying the message
+func (r *OutboxRepository) MarkDead(ctx context.Context, id int64, lastError string) error {
+	const query = `
+		UPDATE outbox
+		SET status = 'DEAD', attempts = attempts + 1, last_error = $2
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, lastError); err != nil {
+		slog.Error("cannot mark outbox message dead", "error", err, "id", id)
+		return err
+	}
+
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/437d5/pr-review-manager/internal/domain/models"
@@ -32,33 +34,185 @@ func (r *PullRequestRepository) Create(ctx context.Context, pr models.PullReques
 		return models.PullRequest{}, err
 	}
 
-	const reviewerQuery = `
+	if err := r.AssignReviewers(ctx, pr.ID, pr.AssignedReviewers); err != nil {
+		return models.PullRequest{}, err
+	}
+
+	if err := r.MarkFallbackReviewers(ctx, pr.ID, pr.FallbackReviewers); err != nil {
+		return models.PullRequest{}, err
+	}
+
+	// draft keeps requested reviewers unassigned until it is marked ready
+	if pr.Status == models.PRStatusDraft {
+		if err := r.addDraftRequests(ctx, pr.ID, pr.RequestedReviewers); err != nil {
+			return models.PullRequest{}, err
+		}
+	} else if err := r.markReviewers(ctx, pr.ID, "is_requested", pr.RequestedReviewers); err != nil {
+		return models.PullRequest{}, err
+	}
+
+	if err := r.addChangedFiles(ctx, pr.ID, pr.ChangedFiles); err != nil {
+		return models.PullRequest{}, err
+	}
+
+	return pr, nil
+}
+
+// MarkFallbackReviewers marks assigned reviewers as taken from partner teams
+func (r *PullRequestRepository) MarkFallbackReviewers(ctx context.Context, prID string, reviewerIDs []string) error {
+	return r.markReviewers(ctx, prID, "is_fallback", reviewerIDs)
+}
+
+// AssignRequestedReviewers assigns reviewers requested on the draft and drops its requests
+func (r *PullRequestRepository) AssignRequestedReviewers(ctx context.Context, prID string, reviewerIDs []string) error {
+	const query = `
+		DELETE FROM draft_requested_reviewers
+		WHERE pull_request_id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, prID); err != nil {
+		slog.Error("cannot delete draft requests", "error", err, "pr_id", prID)
+		return err
+	}
+
+	if err := r.AssignReviewers(ctx, prID, reviewerIDs); err != nil {
+		return err
+	}
+
+	return r.markReviewers(ctx, prID, "is_requested", reviewerIDs)
+}
+
+func (r *PullRequestRepository) addDraftRequests(ctx context.Context, prID string, reviewerIDs []string) error {
+	if len(reviewerIDs) == 0 {
+		return nil
+	}
+
+	values := make([]string, len(reviewerIDs))
+	args := make([]any, 0, len(reviewerIDs)*2)
+	for i, reviewerID := range reviewerIDs {
+		values[i] = fmt.Sprintf("($%d, $%d)", i*2+1, i*2+2)
+		args = append(args, prID, reviewerID)
+	}
+
+	query := `
+		INSERT INTO draft_requested_reviewers (pull_request_id, reviewer_id)
+		VALUES ` + strings.Join(values, ", ")
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		slog.Error("cannot add draft requests", "error", err, "pr_id", prID, "count", len(reviewerIDs))
+		return err
+	}
+	return nil
+}
+
+// markReviewers sets the flag column of assigned reviewers
+func (r *PullRequestRepository) markReviewers(ctx context.Context, prID, flag string, reviewerIDs []string) error {
+	if len(reviewerIDs) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE pull_requests_reviewers
+		SET ` + flag + ` = true
+		WHERE pull_request_id = ? AND reviewer_id IN (?)
+	`
+
+	inQuery, args, err := sqlx.In(query, prID, reviewerIDs)
+	if err != nil {
+		slog.Error("cannot build mark reviewers query", "error", err, "flag", flag)
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, r.db.Rebind(inQuery), args...); err != nil {
+		slog.Error("cannot mark reviewers", "error", err, "pr_id", prID, "flag", flag)
+		return err
+	}
+	return nil
+}
+
+// addChangedFiles records changed files of the PR with batched inserts
+func (r *PullRequestRepository) addChangedFiles(ctx context.Context, prID string, paths []string) error {
+	const batchSize = 1000
+
+	for start := 0; start < len(paths); start += batchSize {
+		end := min(start+batchSize, len(paths))
+		batch := paths[start:end]
+
+		values := make([]string, len(batch))
+		args := make([]any, 0, len(batch)*2)
+		for i, path := range batch {
+			values[i] = fmt.Sprintf("($%d, $%d)", i*2+1, i*2+2)
+			args = append(args, prID, path)
+		}
+
+		query := `
+			INSERT INTO pull_request_files (pull_request_id, path)
+			VALUES ` + strings.Join(values, ", ") + `
+			ON CONFLICT DO NOTHING
+		`
+
+		if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+			slog.Error("cannot add changed files of pull request", "error", err, "pr_id", prID, "batch_size", len(batch))
+			return err
+		}
+	}
+	return nil
+}
+
+// AssignReviewers adds reviewers to the PR, already assigned ones are not checked
+func (r *PullRequestRepository) AssignReviewers(ctx context.Context, prID string, reviewerIDs []string) error {
+	const query = `
 		INSERT INTO pull_requests_reviewers (pull_request_id, reviewer_id)
 		VALUES ($1, $2)
 	`
 
-	for _, reviewerID := range pr.AssignedReviewers {
-		if _, err := r.db.ExecContext(ctx, reviewerQuery, pr.ID, reviewerID); err != nil {
-			slog.Error("cannot assign reviewer to pull request", "error", err, "pr_id", pr.ID, "reviewer_id", reviewerID)
-			return models.PullRequest{}, err
+	for _, reviewerID := range reviewerIDs {
+		if _, err := r.db.ExecContext(ctx, query, prID, reviewerID); err != nil {
+			slog.Error("cannot assign reviewer to pull request", "error", err, "pr_id", prID, "reviewer_id", reviewerID)
+			return err
 		}
 	}
 
-	return pr, nil
+	return nil
 }
 
-func (r *PullRequestRepository) Merge(ctx context.Context, ID string) (models.PullRequest, error) {
+// SetStatus moves PR to DRAFT, OPEN or CLOSED, merging goes through Merge
+func (r *PullRequestRepository) SetStatus(ctx context.Context, ID string, status models.PRStatus) (models.PullRequest, error) {
 	const query = `
 		UPDATE pull_requests
-		SET status = 'MERGED', merged_at = $1
+		SET status = $1
 		WHERE id = $2
-		RETURNING id, name, author_id, status, created_at, merged_at
+	`
+
+	res, err := r.db.ExecContext(ctx, query, status, ID)
+	if err != nil {
+		slog.Error("cannot set PR status", "error", err, "pr_id", ID, "status", status)
+		return models.PullRequest{}, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+	if affected == 0 {
+		return models.PullRequest{}, models.ErrPullRequestNotFound
+	}
+
+	return r.GetByID(ctx, ID)
+}
+
+func (r *PullRequestRepository) Merge(ctx context.Context, ID string, force bool) (models.PullRequest, error) {
+	const query = `
+		UPDATE pull_requests
+		SET status = 'MERGED', merged_at = $1, force_merged = $2
+		WHERE id = $3
+		RETURNING id, name, author_id, status, created_at, merged_at, force_merged
 	`
 
 	now := time.Now()
 
 	var prDTO dto.PullRequestDTO
-	err := sqlx.GetContext(ctx, r.db, &prDTO, query, now, ID)
+	err := sqlx.GetContext(ctx, r.db, &prDTO, query, now, force, ID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return models.PullRequest{}, models.ErrPullRequestNotFound
@@ -67,24 +221,20 @@ func (r *PullRequestRepository) Merge(ctx context.Context, ID string) (models.Pu
 		return models.PullRequest{}, err
 	}
 
-	// need to refactor
-	reviewers, err := r.GetReviewers(ctx, ID)
-	if err != nil {
-		slog.Error("cannot get reviewers", "error", err, "id", ID)
-	}
-
 	pr, err := prDTO.ToDomain()
 	if err != nil {
 		slog.Error("cannot convert to domain")
 		return models.PullRequest{}, err
 	}
 
-	pr.AssignedReviewers = make([]string, len(reviewers))
-	for i, reviewer := range reviewers {
-		pr.AssignedReviewers[i] = reviewer.ID
+	prs := []models.PullRequest{pr}
+	if err := r.fillReviews(ctx, prs); err != nil {
+		slog.Error("cannot get reviewers", "error", err, "id", ID)
+		return models.PullRequest{}, err
 	}
+	pr = prs[0]
 
-	slog.Info("PR merged successfully", "pr_id", ID, "merged_at", now)
+	slog.Info("PR merged successfully", "pr_id", ID, "merged_at", now, "force", force)
 	return pr, nil
 }
 
@@ -125,7 +275,8 @@ func (r *PullRequestRepository) GetReviewers(ctx context.Context, ID string) ([]
 func (r *PullRequestRepository) Reassign(ctx context.Context, prID, oldReviewerID, newReviewerID string) (models.PullRequest, error) {
 	const query = `
 		UPDATE pull_requests_reviewers
-		SET reviewer_id = $1
+		SET reviewer_id = $1, assigned_at = CURRENT_TIMESTAMP, decision = NULL, decided_at = NULL,
+			is_requested = false
 		WHERE pull_request_id = $2 AND reviewer_id = $3
 		RETURNING pull_request_id
 	`
@@ -141,7 +292,197 @@ func (r *PullRequestRepository) Reassign(ctx context.Context, prID, oldReviewerI
 
 }
 
-func (r *PullRequestRepository) GetPRs(ctx context.Context, userID string) ([]models.PullRequest, error) {
+// AddReviewer assigns one more reviewer to the PR
+func (r *PullRequestRepository) AddReviewer(ctx context.Context, prID, reviewerID string) (models.PullRequest, error) {
+	const query = `
+		INSERT INTO pull_requests_reviewers (pull_request_id, reviewer_id)
+		VALUES ($1, $2)
+		ON CONFLICT (pull_request_id, reviewer_id) DO NOTHING
+	`
+
+	res, err := r.db.ExecContext(ctx, query, prID, reviewerID)
+	if err != nil {
+		slog.Error("cannot add reviewer to pull request", "error", err, "pr_id", prID, "reviewer_id", reviewerID)
+		return models.PullRequest{}, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		slog.Error("cannot get affected rows", "error", err, "pr_id", prID)
+		return models.PullRequest{}, err
+	}
+	if affected == 0 {
+		return models.PullRequest{}, models.ErrReviewerAlreadyAssigned
+	}
+
+	return r.GetByID(ctx, prID)
+}
+
+// RemoveReviewer unassigns the reviewer from the PR, their decision is dropped too
+func (r *PullRequestRepository) RemoveReviewer(ctx context.Context, prID, reviewerID string) (models.PullRequest, error) {
+	const query = `
+		DELETE FROM pull_requests_reviewers
+		WHERE pull_request_id = $1 AND reviewer_id = $2
+	`
+
+	res, err := r.db.ExecContext(ctx, query, prID, reviewerID)
+	if err != nil {
+		slog.Error("cannot remove reviewer from pull request", "error", err, "pr_id", prID, "reviewer_id", reviewerID)
+		return models.PullRequest{}, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		slog.Error("cannot get affected rows", "error", err, "pr_id", prID)
+		return models.PullRequest{}, err
+	}
+	if affected == 0 {
+		return models.PullRequest{}, models.ErrUserNotReviewer
+	}
+
+	return r.GetByID(ctx, prID)
+}
+
+// GetPRs returns a page of PRs where the user is a reviewer,
+// reviewer of the filter is replaced with the user
+func (r *PullRequestRepository) GetPRs(ctx context.Context, userID string, filter models.PRFilter) (models.PRPage, error) {
+	filter.ReviewerID = userID
+	return r.List(ctx, filter)
+}
+
+// CountPRs returns the number of PRs matching the filter, cursor and limit are ignored
+func (r *PullRequestRepository) CountPRs(ctx context.Context, filter models.PRFilter) (int, error) {
+	conditions, args := prFilterConditions(filter)
+	query := `
+		SELECT COUNT(*)
+		FROM pull_requests pr
+	` + whereClause(conditions)
+
+	var count int
+	if err := sqlx.GetContext(ctx, r.db, &count, query, args...); err != nil {
+		slog.Error("cannot count pull requests", "error", err, "filter", filter)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// List returns a page of PRs matching the filter, newest first.
+// Ties on created_at are broken by id so that pages never overlap.
+func (r *PullRequestRepository) List(ctx context.Context, filter models.PRFilter) (models.PRPage, error) {
+	after, err := filter.After()
+	if err != nil {
+		return models.PRPage{}, err
+	}
+
+	conditions, args := prFilterConditions(filter)
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ID)
+		conditions = append(conditions, fmt.Sprintf("(pr.created_at, pr.id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	limit := filter.PageLimit()
+	args = append(args, limit+1)
+
+	query := `
+		SELECT
+			pr.id,
+			pr.name,
+			pr.author_id,
+			pr.status,
+			pr.created_at,
+			pr.merged_at,
+			pr.force_merged
+		FROM pull_requests pr
+	` + whereClause(conditions) + fmt.Sprintf(`
+		ORDER BY pr.created_at DESC, pr.id DESC
+		LIMIT $%d
+	`, len(args))
+
+	var prDTOs []dto.PullRequestDTO
+	if err := sqlx.SelectContext(ctx, r.db, &prDTOs, query, args...); err != nil {
+		slog.Error("cannot list pull requests", "error", err, "filter", filter)
+		return models.PRPage{}, err
+	}
+
+	// one extra row tells that there is a next page
+	var page models.PRPage
+	if len(prDTOs) > limit {
+		prDTOs = prDTOs[:limit]
+		last := prDTOs[limit-1]
+		page.NextCursor = models.PRCursor{CreatedAt: last.CreatedAt, ID: last.ID}.Encode()
+	}
+
+	page.PullRequests = make([]models.PullRequest, len(prDTOs))
+	for i, prDTO := range prDTOs {
+		page.PullRequests[i], err = prDTO.ToDomain()
+		if err != nil {
+			slog.Error("cannot convert to domain", "error", err, "pr_id", prDTO.ID)
+			return models.PRPage{}, err
+		}
+	}
+
+	if err := r.fillReviews(ctx, page.PullRequests); err != nil {
+		slog.Error("cannot get reviews for pull requests", "error", err)
+		return models.PRPage{}, err
+	}
+
+	return page, nil
+}
+
+// prFilterConditions builds WHERE conditions for the filter fields except cursor and limit
+func prFilterConditions(filter models.PRFilter) ([]string, []any) {
+	var conditions []string
+	var args []any
+
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.Status != "" {
+		add("pr.status = $%d", filter.Status)
+	}
+	if filter.AuthorID != "" {
+		add("pr.author_id = $%d", filter.AuthorID)
+	}
+	if filter.ReviewerID != "" {
+		add(`EXISTS (
+			SELECT 1 FROM pull_requests_reviewers prr
+			WHERE prr.pull_request_id = pr.id AND prr.reviewer_id = $%d
+		)`, filter.ReviewerID)
+	}
+	if filter.TeamName != "" {
+		add(`pr.author_id IN (
+			SELECT u.id FROM users u
+			INNER JOIN teams t ON u.team_id = t.id
+			WHERE t.name = $%d
+		)`, filter.TeamName)
+	}
+	if filter.CreatedFrom != nil {
+		add("pr.created_at >= $%d", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		add("pr.created_at < $%d", *filter.CreatedTo)
+	}
+
+	return conditions, args
+}
+
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(conditions, " AND ")
+}
+
+// GetOpenByReviewers returns OPEN pull requests where any of the users is a reviewer,
+// assigned reviewers of each PR are filled
+func (r *PullRequestRepository) GetOpenByReviewers(ctx context.Context, reviewerIDs []string) ([]models.PullRequest, error) {
+	if len(reviewerIDs) == 0 {
+		return []models.PullRequest{}, nil
+	}
+
 	const query = `
 		SELECT
 			pr.id,
@@ -151,29 +492,162 @@ func (r *PullRequestRepository) GetPRs(ctx context.Context, userID string) ([]mo
 			pr.created_at,
 			pr.merged_at
 		FROM pull_requests pr
-		INNER JOIN pull_requests_reviewers prr ON pr.id = prr.pull_request_id
-		WHERE prr.reviewer_id = $1
-		ORDER BY pr.created_at DESC
+		WHERE pr.status = 'OPEN'
+			AND EXISTS (
+				SELECT 1
+				FROM pull_requests_reviewers prr
+				WHERE prr.pull_request_id = pr.id
+					AND prr.reviewer_id IN (?)
+			)
+		ORDER BY pr.created_at, pr.id
 	`
 
+	inQuery, args, err := sqlx.In(query, reviewerIDs)
+	if err != nil {
+		slog.Error("cannot build open pull requests query", "error", err)
+		return nil, err
+	}
+
 	var prDTOs []dto.PullRequestDTO
-	err := sqlx.SelectContext(ctx, r.db, &prDTOs, query, userID)
+	err = sqlx.SelectContext(ctx, r.db, &prDTOs, r.db.Rebind(inQuery), args...)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return []models.PullRequest{}, nil
-		}
-		slog.Error("cannot get pull requests for user", "error", err, "user_id", userID)
-		return []models.PullRequest{}, err
+		slog.Error("cannot get open pull requests for reviewers", "error", err, "reviewer_ids", reviewerIDs)
+		return nil, err
 	}
 
 	prs := make([]models.PullRequest, len(prDTOs))
 	for i, dto := range prDTOs {
-		prs[i], _ = dto.ToDomain()
+		prs[i], err = dto.ToDomain()
+		if err != nil {
+			slog.Error("cannot convert to domain", "error", err, "pr_id", dto.ID)
+			return nil, err
+		}
+	}
+
+	if err := r.fillReviews(ctx, prs); err != nil {
+		slog.Error("cannot get reviews for pull requests", "error", err, "reviewer_ids", reviewerIDs)
+		return nil, err
 	}
 
 	return prs, nil
 }
 
+// fillReviews sets assigned reviewers and their decisions for each of prs,
+// reviewers requested on drafts are added to requested ones
+func (r *PullRequestRepository) fillReviews(ctx context.Context, prs []models.PullRequest) error {
+	if len(prs) == 0 {
+		return nil
+	}
+
+	prIDs := make([]string, len(prs))
+	for i, pr := range prs {
+		prIDs[i] = pr.ID
+	}
+
+	const query = `
+		SELECT
+			pull_request_id,
+			reviewer_id,
+			decision,
+			decided_at,
+			is_fallback,
+			is_requested
+		FROM pull_requests_reviewers
+		WHERE pull_request_id IN (?)
+		ORDER BY id
+	`
+
+	inQuery, args, err := sqlx.In(query, prIDs)
+	if err != nil {
+		slog.Error("cannot build reviewers query", "error", err)
+		return err
+	}
+
+	var reviewDTOs []dto.Review
+	err = sqlx.SelectContext(ctx, r.db, &reviewDTOs, r.db.Rebind(inQuery), args...)
+	if err != nil {
+		slog.Error("cannot get reviewers of pull requests", "error", err)
+		return err
+	}
+
+	reviews := make(map[string][]models.Review, len(prs))
+	for _, review := range reviewDTOs {
+		reviews[review.PullRequestID] = append(reviews[review.PullRequestID], review.ToDomain())
+	}
+
+	const draftQuery = `
+		SELECT pull_request_id, reviewer_id
+		FROM draft_requested_reviewers
+		WHERE pull_request_id IN (?)
+		ORDER BY id
+	`
+
+	inQuery, args, err = sqlx.In(draftQuery, prIDs)
+	if err != nil {
+		slog.Error("cannot build draft requests query", "error", err)
+		return err
+	}
+
+	var requestDTOs []dto.DraftRequest
+	err = sqlx.SelectContext(ctx, r.db, &requestDTOs, r.db.Rebind(inQuery), args...)
+	if err != nil {
+		slog.Error("cannot get draft requests of pull requests", "error", err)
+		return err
+	}
+
+	draftRequests := make(map[string][]string, len(prs))
+	for _, request := range requestDTOs {
+		draftRequests[request.PullRequestID] = append(draftRequests[request.PullRequestID], request.ReviewerID)
+	}
+
+	for i := range prs {
+		prs[i].Reviews = reviews[prs[i].ID]
+		prs[i].AssignedReviewers = make([]string, len(prs[i].Reviews))
+		for j, review := range prs[i].Reviews {
+			prs[i].AssignedReviewers[j] = review.ReviewerID
+			if review.Fallback {
+				prs[i].FallbackReviewers = append(prs[i].FallbackReviewers, review.ReviewerID)
+			}
+			if review.Requested {
+				prs[i].RequestedReviewers = append(prs[i].RequestedReviewers, review.ReviewerID)
+			}
+		}
+		prs[i].RequestedReviewers = append(prs[i].RequestedReviewers, draftRequests[prs[i].ID]...)
+	}
+
+	return nil
+}
+
+// SubmitReview stores decision of the reviewer on pull request
+func (r *PullRequestRepository) SubmitReview(
+	ctx context.Context,
+	prID, reviewerID string,
+	decision models.ReviewDecision,
+) (models.PullRequest, error) {
+	const query = `
+		UPDATE pull_requests_reviewers
+		SET decision = $1, decided_at = CURRENT_TIMESTAMP
+		WHERE pull_request_id = $2 AND reviewer_id = $3
+	`
+
+	res, err := r.db.ExecContext(ctx, query, decision, prID, reviewerID)
+	if err != nil {
+		slog.Error("cannot submit review", "error", err, "pr_id", prID, "reviewer_id", reviewerID)
+		return models.PullRequest{}, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		slog.Error("cannot get affected rows", "error", err, "pr_id", prID)
+		return models.PullRequest{}, err
+	}
+	if affected == 0 {
+		return models.PullRequest{}, models.ErrUserNotReviewer
+	}
+
+	return r.GetByID(ctx, prID)
+}
+
 func (r *PullRequestRepository) GetByID(ctx context.Context, ID string) (models.PullRequest, error) {
 	const query = `
 		SELECT
@@ -182,7 +656,8 @@ func (r *PullRequestRepository) GetByID(ctx context.Context, ID string) (models.
 			pr.author_id,
 			pr.status,
 			pr.created_at,
-			pr.merged_at
+			pr.merged_at,
+			pr.force_merged
 		FROM pull_requests pr
 		WHERE pr.id = $1
 	`
@@ -197,21 +672,176 @@ func (r *PullRequestRepository) GetByID(ctx context.Context, ID string) (models.
 		return models.PullRequest{}, err
 	}
 
-	reviewers, err := r.GetReviewers(ctx, ID)
+	pr, err := prDTO.ToDomain()
 	if err != nil {
+		slog.Error("cannot convert to domain")
+		return models.PullRequest{}, err
+	}
+
+	prs := []models.PullRequest{pr}
+	if err := r.fillReviews(ctx, prs); err != nil {
 		slog.Error("cannot get reviewers", "error", err, "id", ID)
+		return models.PullRequest{}, err
 	}
 
-	pr, err := prDTO.ToDomain()
-	if err != nil {
-		slog.Error("cannot convert to domain")
+	const filesQuery = `
+		SELECT path
+		FROM pull_request_files
+		WHERE pull_request_id = $1
+		ORDER BY path
+	`
+
+	if err := sqlx.SelectContext(ctx, r.db, &prs[0].ChangedFiles, filesQuery, ID); err != nil {
+		slog.Error("cannot get changed files of pull request", "error", err, "pr_id", ID)
 		return models.PullRequest{}, err
 	}
 
-	pr.AssignedReviewers = make([]string, len(reviewers))
-	for i, reviewer := range reviewers {
-		pr.AssignedReviewers[i] = reviewer.ID
+	return prs[0], nil
+}
+
+// GetReviewerDetails returns assigned reviewers of the PR with user details,
+// ordered by assignment time
+func (r *PullRequestRepository) GetReviewerDetails(ctx context.Context, prID string) ([]models.Reviewer, error) {
+	const query = `
+		SELECT
+			u.id,
+			u.username,
+			u.is_active,
+			COALESCE(t.name, '') AS team_name,
+			prr.assigned_at,
+			prr.is_fallback,
+			prr.is_requested
+		FROM pull_requests_reviewers prr
+		INNER JOIN users u ON u.id = prr.reviewer_id
+		LEFT JOIN teams t ON u.team_id = t.id
+		WHERE prr.pull_request_id = $1
+		ORDER BY prr.assigned_at, u.id
+	`
+
+	var reviewerDTOs []dto.Reviewer
+	if err := sqlx.SelectContext(ctx, r.db, &reviewerDTOs, query, prID); err != nil {
+		slog.Error("cannot get PR reviewer details", "error", err, "pr_id", prID)
+		return nil, err
+	}
+
+	reviewers := make([]models.Reviewer, len(reviewerDTOs))
+	for i, reviewerDTO := range reviewerDTOs {
+		reviewers[i] = reviewerDTO.ToDomain()
 	}
 
-	return pr, nil
+	return reviewers, nil
+}
+
+// CountOpenReviews returns the number of OPEN pull requests assigned to each of the given users.
+// Users without open reviews are present in the result with zero.
+func (r *PullRequestRepository) CountOpenReviews(ctx context.Context, userIDs []string) (map[string]int, error) {
+	res := make(map[string]int, len(userIDs))
+	if len(userIDs) == 0 {
+		return res, nil
+	}
+
+	const query = `
+		SELECT
+			prr.reviewer_id,
+			COUNT(*) AS open_reviews
+		FROM pull_requests_reviewers prr
+		INNER JOIN pull_requests pr ON pr.id = prr.pull_request_id
+		WHERE pr.status = 'OPEN'
+			AND prr.reviewer_id IN (?)
+		GROUP BY prr.reviewer_id
+	`
+
+	inQuery, args, err := sqlx.In(query, userIDs)
+	if err != nil {
+		slog.Error("cannot build open reviews query", "error", err)
+		return nil, err
+	}
+
+	var loadDTOs []dto.ReviewerLoad
+	err = sqlx.SelectContext(ctx, r.db, &loadDTOs, r.db.Rebind(inQuery), args...)
+	if err != nil {
+		slog.Error("cannot count open reviews", "error", err, "user_ids", userIDs)
+		return nil, err
+	}
+
+	for _, id := range userIDs {
+		res[id] = 0
+	}
+	for _, load := range loadDTOs {
+		res[load.ReviewerID] = load.OpenReviews
+	}
+
+	return res, nil
+}
+
+// GetLastAssignedAt returns the time of the latest assignment for each of the given users.
+// Users who were never assigned are absent from the result.
+func (r *PullRequestRepository) GetLastAssignedAt(ctx context.Context, userIDs []string) (map[string]time.Time, error) {
+	res := make(map[string]time.Time, len(userIDs))
+	if len(userIDs) == 0 {
+		return res, nil
+	}
+
+	const query = `
+		SELECT
+			prr.reviewer_id,
+			MAX(prr.assigned_at) AS last_assigned_at
+		FROM pull_requests_reviewers prr
+		WHERE prr.reviewer_id IN (?)
+		GROUP BY prr.reviewer_id
+	`
+
+	inQuery, args, err := sqlx.In(query, userIDs)
+	if err != nil {
+		slog.Error("cannot build last assignment query", "error", err)
+		return nil, err
+	}
+
+	var assignmentDTOs []dto.ReviewerLastAssignment
+	err = sqlx.SelectContext(ctx, r.db, &assignmentDTOs, r.db.Rebind(inQuery), args...)
+	if err != nil {
+		slog.Error("cannot get last assignments", "error", err, "user_ids", userIDs)
+		return nil, err
+	}
+
+	for _, assignment := range assignmentDTOs {
+		res[assignment.ReviewerID] = assignment.LastAssignedAt
+	}
+
+	return res, nil
+}
+
+// ReassignBulk replaces reviewers on many pull requests with batched set-based updates
+func (r *PullRequestRepository) ReassignBulk(ctx context.Context, reassignments []models.ReviewReassignment) error {
+	const batchSize = 1000
+
+	for start := 0; start < len(reassignments); start += batchSize {
+		end := min(start+batchSize, len(reassignments))
+		batch := reassignments[start:end]
+
+		values := make([]string, len(batch))
+		args := make([]any, 0, len(batch)*3)
+		for i, reassignment := range batch {
+			values[i] = fmt.Sprintf("($%d, $%d, $%d)", i*3+1, i*3+2, i*3+3)
+			args = append(args, reassignment.PullRequestID, reassignment.OldReviewerID, reassignment.NewReviewerID)
+		}
+
+		valuesTable := `(VALUES ` + strings.Join(values, ", ") + `) AS v(pull_request_id, old_reviewer_id, new_reviewer_id)`
+
+		query := `
+			UPDATE pull_requests_reviewers prr
+			SET reviewer_id = v.new_reviewer_id, assigned_at = CURRENT_TIMESTAMP,
+				decision = NULL, decided_at = NULL, is_requested = false
+			FROM ` + valuesTable + `
+			WHERE prr.pull_request_id = v.pull_request_id
+				AND prr.reviewer_id = v.old_reviewer_id
+		`
+
+		if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+			slog.Error("cannot reassign reviewers in bulk", "error", err, "batch_size", len(batch))
+			return err
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,162 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/437d5/pr-review-manager/internal/infrastructure/dto"
+	"github.com/jmoiron/sqlx"
+)
+
+type StatsRepository struct {
+	db sqlx.ExtContext
+}
+
+func NewStatsRepository(db sqlx.ExtContext) *StatsRepository {
+	return &StatsRepository{db: db}
+}
+
+// GetReviewerStats returns statistics for every user of the filtered teams,
+// users without assignments are present with zeros
+func (r *StatsRepository) GetReviewerStats(ctx context.Context, filter models.StatsFilter) ([]models.ReviewerStats, error) {
+	var args []any
+	param := func(arg any) string {
+		args = append(args, arg)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	// window filters rows by column within the time range in addition to conditions
+	window := func(column string, conditions ...string) string {
+		if filter.From != nil {
+			conditions = append(conditions, column+" >= "+param(*filter.From))
+		}
+		if filter.To != nil {
+			conditions = append(conditions, column+" < "+param(*filter.To))
+		}
+		return whereClause(conditions)
+	}
+
+	var teamCondition string
+	if filter.TeamName != "" {
+		teamCondition = "WHERE t.name = " + param(filter.TeamName)
+	}
+
+	query := `
+		SELECT
+			u.id AS user_id,
+			u.username,
+			t.name AS team_name,
+			COALESCE(a.assignments, 0) + COALESCE(ra.reassigned_away, 0) AS total_assignments,
+			COALESCE(a.open_assignments, 0) AS open_assignments,
+			COALESCE(ra.reassigned_away, 0) AS reassigned_away,
+			a.avg_time_to_merge_seconds
+		FROM users u
+		INNER JOIN teams t ON u.team_id = t.id
+		LEFT JOIN (
+			SELECT
+				prr.reviewer_id,
+				COUNT(*) AS assignments,
+				COUNT(*) FILTER (WHERE pr.status = 'OPEN') AS open_assignments,
+				AVG(EXTRACT(EPOCH FROM pr.merged_at - prr.assigned_at))
+					FILTER (WHERE pr.status = 'MERGED') AS avg_time_to_merge_seconds
+			FROM pull_requests_reviewers prr
+			INNER JOIN pull_requests pr ON pr.id = prr.pull_request_id
+			` + window("prr.assigned_at") + `
+			GROUP BY prr.reviewer_id
+		) a ON a.reviewer_id = u.id
+		LEFT JOIN (
+			SELECT ae.old_user_id, COUNT(*) AS reassigned_away
+			FROM assignment_events ae
+			-- the replaced assignment is counted by the time it was made, as the current ones are
+			LEFT JOIN LATERAL (
+				SELECT assigned.created_at
+				FROM assignment_events assigned
+				WHERE assigned.pull_request_id = ae.pull_request_id
+					AND assigned.user_id = ae.old_user_id
+					AND assigned.event_type IN ('REVIEWER_ASSIGNED', 'REVIEWER_REASSIGNED')
+					AND assigned.id < ae.id
+				ORDER BY assigned.id DESC
+				LIMIT 1
+			) assigned ON true
+			` + window("COALESCE(assigned.created_at, ae.created_at)", "ae.event_type = 'REVIEWER_REASSIGNED'") + `
+			GROUP BY ae.old_user_id
+		) ra ON ra.old_user_id = u.id
+		` + teamCondition + `
+		ORDER BY t.name, u.username, u.id
+	`
+
+	var statsDTOs []dto.ReviewerStats
+	if err := sqlx.SelectContext(ctx, r.db, &statsDTOs, query, args...); err != nil {
+		slog.Error("cannot get reviewer stats", "error", err, "team_name", filter.TeamName)
+		return nil, err
+	}
+
+	stats := make([]models.ReviewerStats, len(statsDTOs))
+	for i, statsDTO := range statsDTOs {
+		stats[i] = statsDTO.ToDomain()
+	}
+
+	return stats, nil
+}
+
+// GetTeamPRStats returns open PR count and median time to merge
+// of PRs authored by team members
+func (r *StatsRepository) GetTeamPRStats(ctx context.Context, teamName string) (models.TeamStats, error) {
+	const query = `
+		SELECT
+			COUNT(*) FILTER (WHERE pr.status = 'OPEN') AS open_prs,
+			PERCENTILE_CONT(0.5) WITHIN GROUP (
+				ORDER BY EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)
+			) FILTER (WHERE pr.status = 'MERGED') AS median_time_to_merge_seconds
+		FROM pull_requests pr
+		INNER JOIN users u ON pr.author_id = u.id
+		INNER JOIN teams t ON u.team_id = t.id
+		WHERE t.name = $1
+	`
+
+	var statsDTO dto.TeamPRStats
+	if err := sqlx.GetContext(ctx, r.db, &statsDTO, query, teamName); err != nil {
+		slog.Error("cannot get team PR stats", "error", err, "team_name", teamName)
+		return models.TeamStats{}, err
+	}
+
+	return statsDTO.ToDomain(teamName), nil
+}
+
+// GetMergedPerWeek returns merged PR counts of the team for the last weeks
+// including the current one, weeks without merges are present with zero
+func (r *StatsRepository) GetMergedPerWeek(ctx context.Context, teamName string, weeks int) ([]models.WeeklyCount, error) {
+	const query = `
+		SELECT w.week_start, COUNT(merged.id) AS count
+		FROM generate_series(
+			DATE_TRUNC('week', CURRENT_TIMESTAMP) - ($2::int - 1) * INTERVAL '1 week',
+			DATE_TRUNC('week', CURRENT_TIMESTAMP),
+			INTERVAL '1 week'
+		) AS w(week_start)
+		LEFT JOIN (
+			SELECT pr.id, pr.merged_at
+			FROM pull_requests pr
+			INNER JOIN users u ON pr.author_id = u.id
+			INNER JOIN teams t ON u.team_id = t.id
+			WHERE t.name = $1 AND pr.status = 'MERGED'
+		) merged ON merged.merged_at >= w.week_start
+			AND merged.merged_at < w.week_start + INTERVAL '1 week'
+		GROUP BY w.week_start
+		ORDER BY w.week_start
+	`
+
+	var countDTOs []dto.WeeklyCount
+	if err := sqlx.SelectContext(ctx, r.db, &countDTOs, query, teamName, weeks); err != nil {
+		slog.Error("cannot get merged PRs per week", "error", err, "team_name", teamName)
+		return nil, err
+	}
+
+	counts := make([]models.WeeklyCount, len(countDTOs))
+	for i, countDTO := range countDTOs {
+		counts[i] = countDTO.ToDomain()
+	}
+
+	return counts, nil
+}
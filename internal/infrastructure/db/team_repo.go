@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/437d5/pr-review-manager/internal/domain/models"
 	"github.com/437d5/pr-review-manager/internal/infrastructure/dto"
@@ -24,13 +26,22 @@ func (r *TeamRepository) Create(
 	team models.Team,
 ) (int, error) {
 	const query = `
-		INSERT INTO teams (name)
-		VALUES ($1)
+		INSERT INTO teams (
+			name, review_strategy, reviewers_count, required_approvals, block_on_changes_requested, overflow_policy
+		)
+		VALUES ($1, $2, $3, $4, $5, COALESCE(NULLIF($6, ''), 'ASSIGN_ANYWAY'))
 		RETURNING id
 	`
 
 	var id int
-	err := sqlx.GetContext(ctx, r.db, &id, query, team.Name)
+	err := sqlx.GetContext(ctx, r.db, &id, query,
+		team.Name,
+		team.Settings.ReviewStrategy,
+		team.Settings.ReviewersCount,
+		team.Settings.RequiredApprovals,
+		team.Settings.BlockOnChangesRequested,
+		team.Settings.OverflowPolicy,
+	)
 	if err != nil {
 		slog.Error("cannot insert team", "error", err.Error(), "team", team.Name)
 		return 0, err
@@ -60,7 +71,15 @@ func (r *TeamRepository) GetByName(
 	name string,
 ) (models.Team, error) {
 	const teamQuery = `
-		SELECT id, name, created_at
+		SELECT
+			id,
+			name,
+			created_at,
+			review_strategy,
+			reviewers_count,
+			required_approvals,
+			block_on_changes_requested,
+			overflow_policy
 		FROM teams
 		WHERE name = $1
 	`
@@ -95,7 +114,199 @@ func (r *TeamRepository) GetByName(
 		return models.Team{}, err
 	}
 
+	const partnersQuery = `
+		SELECT p.name
+		FROM team_partners tp
+		INNER JOIN teams p ON p.id = tp.partner_team_id
+		WHERE tp.team_id = $1
+		ORDER BY p.name
+	`
+
+	var partners []string
+	err = sqlx.SelectContext(ctx, r.db, &partners, partnersQuery, teamDTO.ID)
+	if err != nil {
+		slog.Error("cannot get partner teams", "error", err.Error(), "team", name)
+		return models.Team{}, err
+	}
+
 	res := dto.TeamWithMembers{Team: teamDTO, Members: usersDTO}
 
-	return res.ToDomain(), nil
+	team := res.ToDomain()
+	team.PartnerTeams = partners
+	return team, nil
+}
+
+func (r *TeamRepository) GetSettings(
+	ctx context.Context,
+	name string,
+) (models.TeamSettings, error) {
+	const query = `
+		SELECT review_strategy, reviewers_count, required_approvals, block_on_changes_requested, overflow_policy
+		FROM teams
+		WHERE name = $1
+	`
+
+	var settingsDTO dto.TeamSettings
+	err := sqlx.GetContext(ctx, r.db, &settingsDTO, query, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.TeamSettings{}, models.ErrTeamNotFound
+		}
+		slog.Error("cannot get team settings", "error", err.Error(), "team", name)
+		return models.TeamSettings{}, err
+	}
+
+	return settingsDTO.ToDomain(), nil
+}
+
+func (r *TeamRepository) UpdateSettings(
+	ctx context.Context,
+	name string,
+	settings models.TeamSettings,
+) (models.TeamSettings, error) {
+	const query = `
+		UPDATE teams
+		SET
+			review_strategy = $1,
+			reviewers_count = $2,
+			required_approvals = $3,
+			block_on_changes_requested = $4,
+			overflow_policy = COALESCE(NULLIF($5, ''), 'ASSIGN_ANYWAY')
+		WHERE name = $6
+		RETURNING review_strategy, reviewers_count, required_approvals, block_on_changes_requested, overflow_policy
+	`
+
+	var settingsDTO dto.TeamSettings
+	err := sqlx.GetContext(ctx, r.db, &settingsDTO, query,
+		settings.ReviewStrategy,
+		settings.ReviewersCount,
+		settings.RequiredApprovals,
+		settings.BlockOnChangesRequested,
+		settings.OverflowPolicy,
+		name,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.TeamSettings{}, models.ErrTeamNotFound
+		}
+		slog.Error("cannot update team settings", "error", err.Error(), "team", name)
+		return models.TeamSettings{}, err
+	}
+
+	return settingsDTO.ToDomain(), nil
+}
+
+// GetOwnershipRules returns CODEOWNERS rules of the team ordered by line
+func (r *TeamRepository) GetOwnershipRules(ctx context.Context, name string) ([]models.OwnershipRule, error) {
+	const query = `
+		SELECT r.line, r.pattern, r.owners
+		FROM team_ownership_rules r
+		JOIN teams t ON t.id = r.team_id
+		WHERE t.name = $1
+		ORDER BY r.line
+	`
+
+	var ruleDTOs []dto.OwnershipRule
+	if err := sqlx.SelectContext(ctx, r.db, &ruleDTOs, query, name); err != nil {
+		slog.Error("cannot get ownership rules", "error", err.Error(), "team", name)
+		return nil, err
+	}
+
+	rules := make([]models.OwnershipRule, len(ruleDTOs))
+	for i, rule := range ruleDTOs {
+		rules[i] = rule.ToDomain()
+	}
+	return rules, nil
+}
+
+// ReplaceOwnershipRules replaces all CODEOWNERS rules of the team with batched inserts
+func (r *TeamRepository) ReplaceOwnershipRules(ctx context.Context, name string, rules []models.OwnershipRule) error {
+	var teamID int
+	err := sqlx.GetContext(ctx, r.db, &teamID, `SELECT id FROM teams WHERE name = $1`, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrTeamNotFound
+		}
+		slog.Error("cannot get team", "error", err.Error(), "team", name)
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM team_ownership_rules WHERE team_id = $1`, teamID); err != nil {
+		slog.Error("cannot delete ownership rules", "error", err.Error(), "team", name)
+		return err
+	}
+
+	const batchSize = 1000
+
+	for start := 0; start < len(rules); start += batchSize {
+		end := min(start+batchSize, len(rules))
+		batch := rules[start:end]
+
+		values := make([]string, len(batch))
+		args := make([]any, 0, len(batch)*4)
+		for i, rule := range batch {
+			values[i] = fmt.Sprintf("($%d, $%d, $%d, $%d)", i*4+1, i*4+2, i*4+3, i*4+4)
+			args = append(args, teamID, rule.Line, rule.Pattern, strings.Join(rule.Owners, " "))
+		}
+
+		query := `
+			INSERT INTO team_ownership_rules (team_id, line, pattern, owners)
+			VALUES ` + strings.Join(values, ", ")
+
+		if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+			slog.Error("cannot insert ownership rules", "error", err.Error(), "team", name, "batch_size", len(batch))
+			return err
+		}
+	}
+	return nil
+}
+
+// ReplacePartners replaces partner teams of the team,
+// ErrTeamNotFound is returned when any of the teams does not exist
+func (r *TeamRepository) ReplacePartners(ctx context.Context, name string, partners []string) error {
+	var teamID int
+	err := sqlx.GetContext(ctx, r.db, &teamID, `SELECT id FROM teams WHERE name = $1`, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrTeamNotFound
+		}
+		slog.Error("cannot get team", "error", err.Error(), "team", name)
+		return err
+	}
+
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM team_partners WHERE team_id = $1`, teamID); err != nil {
+		slog.Error("cannot delete partner teams", "error", err.Error(), "team", name)
+		return err
+	}
+
+	if len(partners) == 0 {
+		return nil
+	}
+
+	const query = `
+		INSERT INTO team_partners (team_id, partner_team_id)
+		SELECT ?, id FROM teams WHERE name IN (?)
+	`
+
+	inQuery, args, err := sqlx.In(query, teamID, partners)
+	if err != nil {
+		slog.Error("cannot build partner teams query", "error", err)
+		return err
+	}
+
+	res, err := r.db.ExecContext(ctx, r.db.Rebind(inQuery), args...)
+	if err != nil {
+		slog.Error("cannot insert partner teams", "error", err.Error(), "team", name)
+		return err
+	}
+
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		slog.Error("cannot get affected rows", "error", err.Error(), "team", name)
+		return err
+	}
+	if int(inserted) != len(partners) {
+		return models.ErrTeamNotFound
+	}
+	return nil
 }
@@ -38,6 +38,27 @@ func (u *UnitOfWork) PR() repositories.PullRequestRepository {
 	return NewPullRequestRepository(u.db)
 }
 
+func (u *UnitOfWork) Stats() repositories.StatsRepository {
+	if u.tx != nil {
+		return NewStatsRepository(u.tx)
+	}
+	return NewStatsRepository(u.db)
+}
+
+func (u *UnitOfWork) Events() repositories.EventRepository {
+	if u.tx != nil {
+		return NewEventRepository(u.tx)
+	}
+	return NewEventRepository(u.db)
+}
+
+func (u *UnitOfWork) Outbox() repositories.OutboxRepository {
+	if u.tx != nil {
+		return NewOutboxRepository(u.tx)
+	}
+	return NewOutboxRepository(u.db)
+}
+
 func (u *UnitOfWork) Begin(ctx context.Context) error {
 	if u.tx != nil {
 		return fmt.Errorf("transaction already started")
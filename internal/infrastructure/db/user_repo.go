@@ -4,13 +4,44 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/437d5/pr-review-manager/internal/domain/models"
 	"github.com/437d5/pr-review-manager/internal/infrastructure/dto"
 	"github.com/jmoiron/sqlx"
 )
 
+// candidateColumns are selected for candidate reviewers,
+// they include review limits and numbers of open reviews of the users
+const candidateColumns = `
+			u.id,
+			u.username,
+			u.team_id,
+			u.is_active,
+			u.created_at,
+			u.max_open_reviews,
+			u.timezone,
+			u.work_start,
+			u.work_end,
+			(
+				SELECT COUNT(*)
+				FROM pull_requests_reviewers prr
+				INNER JOIN pull_requests pr ON pr.id = prr.pull_request_id
+				WHERE prr.reviewer_id = u.id AND pr.status = 'OPEN'
+			) AS open_reviews,
+			t.name as team_name`
+
+// notAbsentNow filters out candidates u who are out of office now
+const notAbsentNow = `NOT EXISTS (
+				SELECT 1
+				FROM user_absences ua
+				WHERE ua.user_id = u.id
+					AND ua.starts_at <= CURRENT_TIMESTAMP
+					AND ua.ends_at > CURRENT_TIMESTAMP
+			)`
+
 type UserRepository struct {
 	db sqlx.ExtContext
 }
@@ -46,8 +77,13 @@ func (r *UserRepository) GetByID(
 		SELECT 
 			u.id,
 			u.username,
+			u.is_active,
 			u.team_id,
 			u.created_at,
+			u.max_open_reviews,
+			u.timezone,
+			u.work_start,
+			u.work_end,
 			t.name as team_name
 		FROM users u
 		LEFT JOIN teams t ON u.team_id = t.id
@@ -80,6 +116,10 @@ func (r *UserRepository) Update(
 			username,
 			is_active,
 			created_at,
+			max_open_reviews,
+			timezone,
+			work_start,
+			work_end,
 			(SELECT name FROM teams WHERE id = users.team_id) as team_name
 	`
 
@@ -109,6 +149,10 @@ func (r *UserRepository) SetIsActive(
 			username,
 			is_active,
 			created_at,
+			max_open_reviews,
+			timezone,
+			work_start,
+			work_end,
 			(SELECT name FROM teams WHERE id = users.team_id) as team_name
 	`
 	var userDTO dto.User
@@ -123,21 +167,70 @@ func (r *UserRepository) SetIsActive(
 	return userDTO.ToDomain(), nil
 }
 
-func (r *UserRepository) GetActiveTeammatesByUserID(ctx context.Context, userID string) ([]models.User, error) {
+// SetTeamIsActive changes activity of the given members of the team with a single update,
+// users outside of the team are left untouched and absent from the result
+func (r *UserRepository) SetTeamIsActive(
+	ctx context.Context,
+	teamName string,
+	ids []string,
+	isActive bool,
+) ([]models.User, error) {
+	if len(ids) == 0 {
+		return []models.User{}, nil
+	}
+
 	const query = `
-		SELECT 
+		UPDATE users u
+		SET is_active = ?
+		FROM teams t
+		WHERE u.team_id = t.id
+			AND t.name = ?
+			AND u.id IN (?)
+		RETURNING
 			u.id,
 			u.username,
-			u.team_id,
 			u.is_active,
+			u.team_id,
 			u.created_at,
+			u.max_open_reviews,
+			u.timezone,
+			u.work_start,
+			u.work_end,
 			t.name as team_name
+	`
+
+	inQuery, args, err := sqlx.In(query, isActive, teamName, ids)
+	if err != nil {
+		slog.Error("cannot build update users query", "error", err.Error())
+		return nil, err
+	}
+
+	var userDTOs []dto.User
+	if err := sqlx.SelectContext(ctx, r.db, &userDTOs, r.db.Rebind(inQuery), args...); err != nil {
+		slog.Error("cannot update team users", "error", err.Error(), "team", teamName, "is_active", isActive)
+		return nil, err
+	}
+
+	users := make([]models.User, len(userDTOs))
+	for i, dto := range userDTOs {
+		users[i] = dto.ToDomain()
+	}
+
+	return users, nil
+}
+
+// GetActiveTeammatesByUserID returns active members of the user's team who are not absent now
+// with their review limits and numbers of open reviews
+func (r *UserRepository) GetActiveTeammatesByUserID(ctx context.Context, userID string) ([]models.User, error) {
+	const query = `
+		SELECT` + candidateColumns + `
 		FROM users u
 		INNER JOIN users cu ON cu.team_id = u.team_id
 		LEFT JOIN teams t ON u.team_id = t.id
 		WHERE cu.id = $1
 			AND u.id != $1
 			AND u.is_active = true
+			AND ` + notAbsentNow + `
 		ORDER BY u.username
 	`
 
@@ -158,3 +251,250 @@ func (r *UserRepository) GetActiveTeammatesByUserID(ctx context.Context, userID
 
 	return users, nil
 }
+
+// GetActivePartnersByUserID returns active members of partner teams of the user's team
+// who are not absent now with their review limits and numbers of open reviews
+func (r *UserRepository) GetActivePartnersByUserID(ctx context.Context, userID string) ([]models.User, error) {
+	const query = `
+		SELECT` + candidateColumns + `
+		FROM users cu
+		INNER JOIN team_partners tp ON tp.team_id = cu.team_id
+		INNER JOIN users u ON u.team_id = tp.partner_team_id
+		LEFT JOIN teams t ON u.team_id = t.id
+		WHERE cu.id = $1
+			AND u.id != $1
+			AND u.is_active = true
+			AND ` + notAbsentNow + `
+		ORDER BY u.username
+	`
+
+	var userDTOs []dto.User
+	err := sqlx.SelectContext(ctx, r.db, &userDTOs, query, userID)
+	if err != nil {
+		slog.Error("cannot get active partners", "error", err.Error(), "user_id", userID)
+		return []models.User{}, err
+	}
+
+	users := make([]models.User, len(userDTOs))
+	for i, dto := range userDTOs {
+		users[i] = dto.ToDomain()
+	}
+
+	return users, nil
+}
+
+// GetActiveMembersByTeamName returns active members of the team who are not absent now
+// with their review limits and numbers of open reviews
+func (r *UserRepository) GetActiveMembersByTeamName(ctx context.Context, teamName string) ([]models.User, error) {
+	const query = `
+		SELECT` + candidateColumns + `
+		FROM users u
+		INNER JOIN teams t ON u.team_id = t.id
+		WHERE t.name = $1
+			AND u.is_active = true
+			AND ` + notAbsentNow + `
+		ORDER BY u.username
+	`
+
+	var userDTOs []dto.User
+	if err := sqlx.SelectContext(ctx, r.db, &userDTOs, query, teamName); err != nil {
+		slog.Error("cannot get active team members", "error", err.Error(), "team", teamName)
+		return []models.User{}, err
+	}
+
+	users := make([]models.User, len(userDTOs))
+	for i, dto := range userDTOs {
+		users[i] = dto.ToDomain()
+	}
+
+	return users, nil
+}
+
+// SetMaxOpenReviews changes review limit of the user, nil removes the limit
+func (r *UserRepository) SetMaxOpenReviews(ctx context.Context, id string, limit *int) (models.User, error) {
+	const query = `
+		UPDATE users
+		SET max_open_reviews = $1
+		WHERE id = $2
+		RETURNING
+			id,
+			username,
+			is_active,
+			created_at,
+			max_open_reviews,
+			timezone,
+			work_start,
+			work_end,
+			(SELECT name FROM teams WHERE id = users.team_id) as team_name
+	`
+
+	var userDTO dto.User
+	if err := sqlx.GetContext(ctx, r.db, &userDTO, query, limit, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.User{}, models.ErrUserNotFound
+		}
+		slog.Error("cannot update user", "error", err.Error(), "id", id, "max_open_reviews", limit)
+		return models.User{}, err
+	}
+
+	return userDTO.ToDomain(), nil
+}
+
+// SetWorkingHours changes timezone and working hours of the user,
+// empty timezone means UTC and nil hours remove the working hours
+func (r *UserRepository) SetWorkingHours(
+	ctx context.Context,
+	id string,
+	timezone string,
+	hours *models.WorkingHours,
+) (models.User, error) {
+	const query = `
+		UPDATE users
+		SET timezone = NULLIF($1, ''), work_start = $2, work_end = $3
+		WHERE id = $4
+		RETURNING
+			id,
+			username,
+			is_active,
+			created_at,
+			max_open_reviews,
+			timezone,
+			work_start,
+			work_end,
+			(SELECT name FROM teams WHERE id = users.team_id) as team_name
+	`
+
+	var start, end sql.NullInt16
+	if hours != nil {
+		startMinute, err := models.ParseClock(hours.Start)
+		if err != nil {
+			return models.User{}, models.ErrInvalidWorkingHours
+		}
+		endMinute, err := models.ParseClock(hours.End)
+		if err != nil {
+			return models.User{}, models.ErrInvalidWorkingHours
+		}
+		start = sql.NullInt16{Int16: int16(startMinute), Valid: true}
+		end = sql.NullInt16{Int16: int16(endMinute), Valid: true}
+	}
+
+	var userDTO dto.User
+	if err := sqlx.GetContext(ctx, r.db, &userDTO, query, timezone, start, end, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.User{}, models.ErrUserNotFound
+		}
+		slog.Error("cannot update user", "error", err.Error(), "id", id, "timezone", timezone)
+		return models.User{}, err
+	}
+
+	return userDTO.ToDomain(), nil
+}
+
+// ReplaceAbsences replaces all absences of the user and returns the stored ones ordered by start.
+// An absence that has started keeps being handled if it overlaps a replaced one whose reviews
+// were already reassigned, so editing an ongoing absence does not reassign the reviews again.
+func (r *UserRepository) ReplaceAbsences(ctx context.Context, userID string, absences []models.Absence) ([]models.Absence, error) {
+	var exists bool
+	err := sqlx.GetContext(ctx, r.db, &exists, `SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)`, userID)
+	if err != nil {
+		slog.Error("cannot check user", "error", err.Error(), "id", userID)
+		return nil, err
+	}
+	if !exists {
+		return nil, models.ErrUserNotFound
+	}
+
+	if len(absences) == 0 {
+		if _, err := r.db.ExecContext(ctx, `DELETE FROM user_absences WHERE user_id = $1`, userID); err != nil {
+			slog.Error("cannot delete absences", "error", err.Error(), "user_id", userID)
+			return nil, err
+		}
+		return []models.Absence{}, nil
+	}
+
+	values := make([]string, len(absences))
+	args := make([]any, 0, len(absences)*2+1)
+	args = append(args, userID)
+	for i, absence := range absences {
+		values[i] = fmt.Sprintf("($%d::timestamptz, $%d::timestamptz)", i*2+2, i*2+3)
+		args = append(args, absence.StartsAt, absence.EndsAt)
+	}
+
+	query := `
+		WITH replaced AS (
+			DELETE FROM user_absences
+			WHERE user_id = $1
+			RETURNING starts_at, ends_at, reassigned_at
+		), inserted AS (
+			INSERT INTO user_absences (user_id, starts_at, ends_at, reassigned_at)
+			SELECT $1, v.starts_at, v.ends_at, (
+				SELECT max(replaced.reassigned_at)
+				FROM replaced
+				WHERE v.starts_at <= CURRENT_TIMESTAMP
+					AND replaced.starts_at < v.ends_at
+					AND v.starts_at < replaced.ends_at
+			)
+			FROM (VALUES ` + strings.Join(values, ", ") + `) AS v(starts_at, ends_at)
+			RETURNING id, user_id, starts_at, ends_at
+		)
+		SELECT id, user_id, starts_at, ends_at
+		FROM inserted
+		ORDER BY starts_at, id
+	`
+
+	var absenceDTOs []dto.Absence
+	if err := sqlx.SelectContext(ctx, r.db, &absenceDTOs, query, args...); err != nil {
+		slog.Error("cannot replace absences", "error", err.Error(), "user_id", userID, "count", len(absences))
+		return nil, err
+	}
+
+	result := make([]models.Absence, len(absenceDTOs))
+	for i, absenceDTO := range absenceDTOs {
+		result[i] = absenceDTO.ToDomain()
+	}
+
+	return result, nil
+}
+
+// GetStartedAbsences locks up to limit absences that are going on now
+// and whose open reviews were not reassigned yet, oldest first.
+// Must be called inside of transaction, absences locked by others are skipped.
+func (r *UserRepository) GetStartedAbsences(ctx context.Context, limit int) ([]models.Absence, error) {
+	const query = `
+		SELECT id, user_id, starts_at, ends_at
+		FROM user_absences
+		WHERE reassigned_at IS NULL
+			AND starts_at <= CURRENT_TIMESTAMP
+			AND ends_at > CURRENT_TIMESTAMP
+		ORDER BY starts_at, id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	var absenceDTOs []dto.Absence
+	if err := sqlx.SelectContext(ctx, r.db, &absenceDTOs, query, limit); err != nil {
+		slog.Error("cannot get started absences", "error", err.Error())
+		return nil, err
+	}
+
+	absences := make([]models.Absence, len(absenceDTOs))
+	for i, absenceDTO := range absenceDTOs {
+		absences[i] = absenceDTO.ToDomain()
+	}
+
+	return absences, nil
+}
+
+func (r *UserRepository) MarkAbsenceReassigned(ctx context.Context, id int64) error {
+	const query = `
+		UPDATE user_absences
+		SET reassigned_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		slog.Error("cannot mark absence reassigned", "error", err.Error(), "id", id)
+		return err
+	}
+	return nil
+}
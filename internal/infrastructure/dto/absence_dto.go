@@ -0,0 +1,23 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+)
+
+type Absence struct {
+	ID       int64     `db:"id"`
+	UserID   string    `db:"user_id"`
+	StartsAt time.Time `db:"starts_at"`
+	EndsAt   time.Time `db:"ends_at"`
+}
+
+func (a Absence) ToDomain() models.Absence {
+	return models.Absence{
+		ID:       a.ID,
+		UserID:   a.UserID,
+		StartsAt: a.StartsAt.UTC(),
+		EndsAt:   a.EndsAt.UTC(),
+	}
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAbsenceDTO_ToDomain(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	absence := Absence{
+		ID:       7,
+		UserID:   "u1",
+		StartsAt: time.Date(2025, 7, 1, 3, 0, 0, 0, moscow),
+		EndsAt:   time.Date(2025, 7, 15, 3, 0, 0, 0, moscow),
+	}
+
+	assert.Equal(t, models.Absence{
+		ID:       7,
+		UserID:   "u1",
+		StartsAt: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:   time.Date(2025, 7, 15, 0, 0, 0, 0, time.UTC),
+	}, absence.ToDomain())
+}
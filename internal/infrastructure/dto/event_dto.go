@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+)
+
+type AssignmentEvent struct {
+	ID            int64          `db:"id"`
+	Type          string         `db:"event_type"`
+	PullRequestID sql.NullString `db:"pull_request_id"`
+	UserID        sql.NullString `db:"user_id"`
+	OldUserID     sql.NullString `db:"old_user_id"`
+	Reason        sql.NullString `db:"reason"`
+	ActorID       sql.NullString `db:"actor_id"`
+	CreatedAt     time.Time      `db:"created_at"`
+}
+
+func (e AssignmentEvent) ToDomain() models.AssignmentEvent {
+	return models.AssignmentEvent{
+		ID:            e.ID,
+		Type:          models.AssignmentEventType(e.Type),
+		PullRequestID: e.PullRequestID.String,
+		UserID:        e.UserID.String,
+		OldUserID:     e.OldUserID.String,
+		Reason:        e.Reason.String,
+		ActorID:       e.ActorID.String,
+		CreatedAt:     e.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// NullString maps empty string to NULL
+func NullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssignmentEventDTO_ToDomain(t *testing.T) {
+	createdAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+
+	event := AssignmentEvent{
+		ID:            7,
+		Type:          "REVIEWER_REASSIGNED",
+		PullRequestID: sql.NullString{String: "pr-1", Valid: true},
+		UserID:        sql.NullString{String: "u3", Valid: true},
+		OldUserID:     sql.NullString{String: "u2", Valid: true},
+		Reason:        sql.NullString{String: "on vacation", Valid: true},
+		CreatedAt:     createdAt,
+	}
+
+	assert.Equal(t, models.AssignmentEvent{
+		ID:            7,
+		Type:          models.EventReviewerReassigned,
+		PullRequestID: "pr-1",
+		UserID:        "u3",
+		OldUserID:     "u2",
+		Reason:        "on vacation",
+		CreatedAt:     "2025-10-24T12:00:00Z",
+	}, event.ToDomain())
+}
+
+func TestNullString(t *testing.T) {
+	assert.False(t, NullString("").Valid)
+	assert.Equal(t, sql.NullString{String: "u1", Valid: true}, NullString("u1"))
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+)
+
+type OutboxMessage struct {
+	ID               int64       `db:"id"`
+	Consumer         string      `db:"consumer"`
+	Topic            string      `db:"topic"`
+	Payload          []byte      `db:"payload"`
+	Attempts         int         `db:"attempts"`
+	DeliveredTargets StringArray `db:"delivered_targets"`
+}
+
+func (m OutboxMessage) ToDomain() models.OutboxMessage {
+	return models.OutboxMessage{
+		ID:               m.ID,
+		Consumer:         m.Consumer,
+		Topic:            m.Topic,
+		Payload:          json.RawMessage(m.Payload),
+		Attempts:         m.Attempts,
+		DeliveredTargets: m.DeliveredTargets,
+	}
+}
+
+// StringArray scans JSONB array of strings
+type StringArray []string
+
+func (a *StringArray) Scan(src any) error {
+	var data []byte
+	switch v := src.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	case nil:
+		*a = nil
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into StringArray", src)
+	}
+	return json.Unmarshal(data, (*[]string)(a))
+}
@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxMessageDTO_ToDomain(t *testing.T) {
+	message := OutboxMessage{
+		ID:               3,
+		Consumer:         "webhooks",
+		Topic:            "pr.merged",
+		Payload:          []byte(`{"type":"pr.merged"}`),
+		Attempts:         2,
+		DeliveredTargets: StringArray{"https://a.example"},
+	}
+
+	assert.Equal(t, models.OutboxMessage{
+		ID:               3,
+		Consumer:         "webhooks",
+		Topic:            "pr.merged",
+		Payload:          json.RawMessage(`{"type":"pr.merged"}`),
+		Attempts:         2,
+		DeliveredTargets: []string{"https://a.example"},
+	}, message.ToDomain())
+}
+
+func TestStringArray_Scan(t *testing.T) {
+	var targets StringArray
+
+	require.NoError(t, targets.Scan([]byte(`["https://a.example","https://b.example"]`)))
+	assert.Equal(t, StringArray{"https://a.example", "https://b.example"}, targets)
+
+	require.NoError(t, targets.Scan(nil))
+	assert.Nil(t, targets)
+
+	assert.Error(t, targets.Scan(42))
+}
@@ -19,24 +19,36 @@ type PullRequestDTO struct {
 	Status    string       `db:"status"`
 	CreatedAt time.Time    `db:"created_at"`
 	MergedAt  sql.NullTime `db:"merged_at"`
+	// ForceMerged is selected only where it matters
+	ForceMerged bool `db:"force_merged"`
 }
 
 func (pr PullRequestDTO) ToDomain() (models.PullRequest, error) {
 	var status models.PRStatus
 	switch pr.Status {
+	case string(models.PRStatusDraft):
+		status = models.PRStatusDraft
 	case string(models.PRStatusOpen):
 		status = models.PRStatusOpen
 	case string(models.PRStatusMerged):
 		status = models.PRStatusMerged
+	case string(models.PRStatusClosed):
+		status = models.PRStatusClosed
 	default:
 		return models.PullRequest{}, ErrInvalidStatus
 	}
 
 	domainPR := models.PullRequest{
-		ID:       pr.ID,
-		Name:     pr.Name,
-		AuthorID: pr.AuthorID,
-		Status:   status,
+		ID:          pr.ID,
+		Name:        pr.Name,
+		AuthorID:    pr.AuthorID,
+		Status:      status,
+		ForceMerged: pr.ForceMerged,
+	}
+
+	if !pr.CreatedAt.IsZero() {
+		createdAtStr := pr.CreatedAt.Format(time.RFC3339)
+		domainPR.CreatedAt = &createdAtStr
 	}
 
 	if pr.MergedAt.Valid {
@@ -33,11 +33,12 @@ func TestPullRequestDTO_ToDomain(t *testing.T) {
 				},
 			},
 			expected: models.PullRequest{
-				ID:       "pr-1",
-				Name:     "PR 1",
-				AuthorID: "author",
-				Status:   models.PRStatusOpen,
-				MergedAt: &nowStr,
+				ID:        "pr-1",
+				Name:      "PR 1",
+				AuthorID:  "author",
+				Status:    models.PRStatusOpen,
+				CreatedAt: &nowStr,
+				MergedAt:  &nowStr,
 			},
 			expectedError: nil,
 		},
@@ -55,11 +56,12 @@ func TestPullRequestDTO_ToDomain(t *testing.T) {
 				},
 			},
 			expected: models.PullRequest{
-				ID:       "pr-1",
-				Name:     "PR 1",
-				AuthorID: "author",
-				Status:   models.PRStatusMerged,
-				MergedAt: &nowStr,
+				ID:        "pr-1",
+				Name:      "PR 1",
+				AuthorID:  "author",
+				Status:    models.PRStatusMerged,
+				CreatedAt: &nowStr,
+				MergedAt:  &nowStr,
 			},
 			expectedError: nil,
 		},
@@ -77,11 +79,48 @@ func TestPullRequestDTO_ToDomain(t *testing.T) {
 				},
 			},
 			expected: models.PullRequest{
-				ID:       "pr-1",
-				Name:     "PR 1",
-				AuthorID: "author",
-				Status:   models.PRStatusOpen,
-				MergedAt: nil,
+				ID:        "pr-1",
+				Name:      "PR 1",
+				AuthorID:  "author",
+				Status:    models.PRStatusOpen,
+				CreatedAt: &nowStr,
+				MergedAt:  nil,
+			},
+			expectedError: nil,
+		},
+		{
+			name: "draft PR",
+			pr: PullRequestDTO{
+				ID:        "pr-1",
+				Name:      "PR 1",
+				AuthorID:  "author",
+				Status:    "DRAFT",
+				CreatedAt: now,
+			},
+			expected: models.PullRequest{
+				ID:        "pr-1",
+				Name:      "PR 1",
+				AuthorID:  "author",
+				Status:    models.PRStatusDraft,
+				CreatedAt: &nowStr,
+			},
+			expectedError: nil,
+		},
+		{
+			name: "closed PR",
+			pr: PullRequestDTO{
+				ID:        "pr-1",
+				Name:      "PR 1",
+				AuthorID:  "author",
+				Status:    "CLOSED",
+				CreatedAt: now,
+			},
+			expected: models.PullRequest{
+				ID:        "pr-1",
+				Name:      "PR 1",
+				AuthorID:  "author",
+				Status:    models.PRStatusClosed,
+				CreatedAt: &nowStr,
 			},
 			expectedError: nil,
 		},
@@ -99,11 +138,12 @@ func TestPullRequestDTO_ToDomain(t *testing.T) {
 				},
 			},
 			expected: models.PullRequest{
-				ID:       "pr-1",
-				Name:     "PR 1",
-				AuthorID: "author",
-				Status:   models.PRStatusOpen,
-				MergedAt: &nowStr,
+				ID:        "pr-1",
+				Name:      "PR 1",
+				AuthorID:  "author",
+				Status:    models.PRStatusOpen,
+				CreatedAt: &nowStr,
+				MergedAt:  &nowStr,
 			},
 			expectedError: ErrInvalidStatus,
 		},
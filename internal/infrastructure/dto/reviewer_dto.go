@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+)
+
+type ReviewerLoad struct {
+	ReviewerID  string `db:"reviewer_id"`
+	OpenReviews int    `db:"open_reviews"`
+}
+
+type ReviewerLastAssignment struct {
+	ReviewerID     string    `db:"reviewer_id"`
+	LastAssignedAt time.Time `db:"last_assigned_at"`
+}
+
+type Review struct {
+	PullRequestID string         `db:"pull_request_id"`
+	ReviewerID    string         `db:"reviewer_id"`
+	Decision      sql.NullString `db:"decision"`
+	DecidedAt     sql.NullTime   `db:"decided_at"`
+	IsFallback    bool           `db:"is_fallback"`
+	IsRequested   bool           `db:"is_requested"`
+}
+
+func (r Review) ToDomain() models.Review {
+	review := models.Review{
+		ReviewerID: r.ReviewerID,
+		Decision:   models.ReviewDecisionPending,
+		Fallback:   r.IsFallback,
+		Requested:  r.IsRequested,
+	}
+
+	if r.Decision.Valid {
+		review.Decision = models.ReviewDecision(r.Decision.String)
+	}
+
+	if r.DecidedAt.Valid {
+		decidedAtStr := r.DecidedAt.Time.Format(time.RFC3339)
+		review.DecidedAt = &decidedAtStr
+	}
+
+	return review
+}
+
+// DraftRequest is a reviewer requested on a draft and not assigned yet
+type DraftRequest struct {
+	PullRequestID string `db:"pull_request_id"`
+	ReviewerID    string `db:"reviewer_id"`
+}
+
+type Reviewer struct {
+	ID          string    `db:"id"`
+	Username    string    `db:"username"`
+	IsActive    bool      `db:"is_active"`
+	TeamName    string    `db:"team_name"`
+	AssignedAt  time.Time `db:"assigned_at"`
+	IsFallback  bool      `db:"is_fallback"`
+	IsRequested bool      `db:"is_requested"`
+}
+
+func (r Reviewer) ToDomain() models.Reviewer {
+	return models.Reviewer{
+		UserID:     r.ID,
+		Username:   r.Username,
+		TeamName:   r.TeamName,
+		IsActive:   r.IsActive,
+		AssignedAt: r.AssignedAt.Format(time.RFC3339),
+		Fallback:   r.IsFallback,
+		Requested:  r.IsRequested,
+	}
+}
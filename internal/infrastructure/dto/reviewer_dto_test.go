@@ -0,0 +1,73 @@
+package dto
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewDTO_ToDomain(t *testing.T) {
+	now := time.Now()
+	nowStr := now.Format(time.RFC3339)
+
+	tests := []struct {
+		name     string
+		review   Review
+		expected models.Review
+	}{
+		{
+			name: "pending review",
+			review: Review{
+				PullRequestID: "pr-1",
+				ReviewerID:    "u1",
+			},
+			expected: models.Review{
+				ReviewerID: "u1",
+				Decision:   models.ReviewDecisionPending,
+			},
+		},
+		{
+			name: "approved review",
+			review: Review{
+				PullRequestID: "pr-1",
+				ReviewerID:    "u1",
+				Decision:      sql.NullString{String: "APPROVED", Valid: true},
+				DecidedAt:     sql.NullTime{Time: now, Valid: true},
+			},
+			expected: models.Review{
+				ReviewerID: "u1",
+				Decision:   models.ReviewDecisionApproved,
+				DecidedAt:  &nowStr,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.review.ToDomain())
+		})
+	}
+}
+
+func TestReviewerDTO_ToDomain(t *testing.T) {
+	assignedAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+
+	reviewer := Reviewer{
+		ID:         "u2",
+		Username:   "Bob",
+		IsActive:   true,
+		TeamName:   "backend",
+		AssignedAt: assignedAt,
+	}
+
+	assert.Equal(t, models.Reviewer{
+		UserID:     "u2",
+		Username:   "Bob",
+		TeamName:   "backend",
+		IsActive:   true,
+		AssignedAt: "2025-10-24T12:00:00Z",
+	}, reviewer.ToDomain())
+}
@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+)
+
+type ReviewerStats struct {
+	UserID                string          `db:"user_id"`
+	Username              string          `db:"username"`
+	TeamName              string          `db:"team_name"`
+	TotalAssignments      int             `db:"total_assignments"`
+	OpenAssignments       int             `db:"open_assignments"`
+	ReassignedAway        int             `db:"reassigned_away"`
+	AvgTimeToMergeSeconds sql.NullFloat64 `db:"avg_time_to_merge_seconds"`
+}
+
+func (s ReviewerStats) ToDomain() models.ReviewerStats {
+	stats := models.ReviewerStats{
+		UserID:           s.UserID,
+		Username:         s.Username,
+		TeamName:         s.TeamName,
+		TotalAssignments: s.TotalAssignments,
+		OpenAssignments:  s.OpenAssignments,
+		ReassignedAway:   s.ReassignedAway,
+	}
+
+	if s.AvgTimeToMergeSeconds.Valid {
+		avg := s.AvgTimeToMergeSeconds.Float64
+		stats.AvgTimeToMergeSeconds = &avg
+	}
+
+	return stats
+}
+
+type TeamPRStats struct {
+	OpenPRs                  int             `db:"open_prs"`
+	MedianTimeToMergeSeconds sql.NullFloat64 `db:"median_time_to_merge_seconds"`
+}
+
+func (s TeamPRStats) ToDomain(teamName string) models.TeamStats {
+	stats := models.TeamStats{
+		TeamName: teamName,
+		OpenPRs:  s.OpenPRs,
+	}
+
+	if s.MedianTimeToMergeSeconds.Valid {
+		median := s.MedianTimeToMergeSeconds.Float64
+		stats.MedianTimeToMergeSeconds = &median
+	}
+
+	return stats
+}
+
+type WeeklyCount struct {
+	WeekStart time.Time `db:"week_start"`
+	Count     int       `db:"count"`
+}
+
+func (c WeeklyCount) ToDomain() models.WeeklyCount {
+	return models.WeeklyCount{
+		WeekStart: c.WeekStart.Format(time.DateOnly),
+		Count:     c.Count,
+	}
+}
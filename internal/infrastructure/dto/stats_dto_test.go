@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewerStatsDTO_ToDomain(t *testing.T) {
+	withoutMerges := ReviewerStats{UserID: "u1", Username: "Alice", TeamName: "backend", TotalAssignments: 2, OpenAssignments: 2}
+	assert.Nil(t, withoutMerges.ToDomain().AvgTimeToMergeSeconds)
+
+	withMerges := ReviewerStats{
+		UserID:                "u2",
+		TotalAssignments:      3,
+		ReassignedAway:        1,
+		AvgTimeToMergeSeconds: sql.NullFloat64{Float64: 90, Valid: true},
+	}
+	stats := withMerges.ToDomain()
+	assert.Equal(t, 1, stats.ReassignedAway)
+	if assert.NotNil(t, stats.AvgTimeToMergeSeconds) {
+		assert.Equal(t, 90.0, *stats.AvgTimeToMergeSeconds)
+	}
+}
+
+func TestTeamPRStatsDTO_ToDomain(t *testing.T) {
+	stats := TeamPRStats{
+		OpenPRs:                  4,
+		MedianTimeToMergeSeconds: sql.NullFloat64{Float64: 7200, Valid: true},
+	}.ToDomain("backend")
+
+	assert.Equal(t, "backend", stats.TeamName)
+	assert.Equal(t, 4, stats.OpenPRs)
+	if assert.NotNil(t, stats.MedianTimeToMergeSeconds) {
+		assert.Equal(t, 7200.0, *stats.MedianTimeToMergeSeconds)
+	}
+}
+
+func TestWeeklyCountDTO_ToDomain(t *testing.T) {
+	count := WeeklyCount{
+		WeekStart: time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC),
+		Count:     3,
+	}
+
+	assert.Equal(t, models.WeeklyCount{WeekStart: "2025-10-20", Count: 3}, count.ToDomain())
+}
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"strings"
 	"time"
 
 	"github.com/437d5/pr-review-manager/internal/domain/models"
@@ -10,6 +11,15 @@ type Team struct {
 	ID        int       `db:"id"`
 	Name      string    `db:"name"`
 	CreatedAt time.Time `db:"created_at"`
+	TeamSettings
+}
+
+type TeamSettings struct {
+	ReviewStrategy          string `db:"review_strategy"`
+	ReviewersCount          int    `db:"reviewers_count"`
+	RequiredApprovals       int    `db:"required_approvals"`
+	BlockOnChangesRequested bool   `db:"block_on_changes_requested"`
+	OverflowPolicy          string `db:"overflow_policy"`
 }
 
 type TeamWithMembers struct {
@@ -17,6 +27,16 @@ type TeamWithMembers struct {
 	Members []User
 }
 
+func (s TeamSettings) ToDomain() models.TeamSettings {
+	return models.TeamSettings{
+		ReviewStrategy:          models.ReviewStrategy(s.ReviewStrategy),
+		ReviewersCount:          s.ReviewersCount,
+		RequiredApprovals:       s.RequiredApprovals,
+		BlockOnChangesRequested: s.BlockOnChangesRequested,
+		OverflowPolicy:          models.OverflowPolicy(s.OverflowPolicy),
+	}
+}
+
 func (t TeamWithMembers) ToDomain() models.Team {
 	members := make([]models.User, len(t.Members))
 	for i, m := range t.Members {
@@ -24,7 +44,22 @@ func (t TeamWithMembers) ToDomain() models.Team {
 	}
 
 	return models.Team{
-		Name:    t.Name,
-		Members: members,
+		Name:     t.Name,
+		Members:  members,
+		Settings: t.TeamSettings.ToDomain(),
+	}
+}
+
+type OwnershipRule struct {
+	Line    int    `db:"line"`
+	Pattern string `db:"pattern"`
+	Owners  string `db:"owners"`
+}
+
+func (r OwnershipRule) ToDomain() models.OwnershipRule {
+	return models.OwnershipRule{
+		Line:    r.Line,
+		Pattern: r.Pattern,
+		Owners:  strings.Fields(r.Owners),
 	}
 }
@@ -21,6 +21,10 @@ func TestTeamDTO_ToDomain(t *testing.T) {
 					ID:        1,
 					Name:      "backend-team",
 					CreatedAt: time.Now(),
+					TeamSettings: TeamSettings{
+						ReviewStrategy: "ROUND_ROBIN",
+						ReviewersCount: 3,
+					},
 				},
 				Members: []User{
 					{ID: "user-1", Username: "User 1", IsActive: true, TeamName: "backend-team"},
@@ -31,6 +35,10 @@ func TestTeamDTO_ToDomain(t *testing.T) {
 				Members: []models.User{
 					{ID: "user-1", Username: "User 1", IsActive: true},
 				},
+				Settings: models.TeamSettings{
+					ReviewStrategy: models.ReviewStrategyRoundRobin,
+					ReviewersCount: 3,
+				},
 			},
 		},
 	}
@@ -39,6 +47,7 @@ func TestTeamDTO_ToDomain(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			team := tt.team.ToDomain()
 			assert.Equal(t, tt.expected.Name, team.Name)
+			assert.Equal(t, tt.expected.Settings, team.Settings)
 			assert.Len(t, team.Members, len(tt.expected.Members))
 			for i := range tt.expected.Members {
 				assert.Equal(t, tt.expected.Members[i].ID, team.Members[i].ID)
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/437d5/pr-review-manager/internal/domain/models"
@@ -13,13 +14,35 @@ type User struct {
 	TeamID    int       `db:"team_id"`
 	TeamName  string    `db:"team_name"`
 	CreatedAt time.Time `db:"created_at"`
+	// MaxOpenReviews is NULL for users without a limit
+	MaxOpenReviews sql.NullInt32 `db:"max_open_reviews"`
+	// Timezone is NULL for users in UTC
+	Timezone sql.NullString `db:"timezone"`
+	// WorkStart and WorkEnd are minutes since midnight, NULL for users without working hours
+	WorkStart sql.NullInt16 `db:"work_start"`
+	WorkEnd   sql.NullInt16 `db:"work_end"`
+	// OpenReviews is selected only for candidate reviewers
+	OpenReviews int `db:"open_reviews"`
 }
 
 func (u User) ToDomain() models.User {
-	return models.User{
-		ID:       u.ID,
-		Username: u.Username,
-		IsActive: u.IsActive,
-		TeamName: u.TeamName,
+	user := models.User{
+		ID:          u.ID,
+		Username:    u.Username,
+		IsActive:    u.IsActive,
+		TeamName:    u.TeamName,
+		Timezone:    u.Timezone.String,
+		OpenReviews: u.OpenReviews,
 	}
+	if u.MaxOpenReviews.Valid {
+		limit := int(u.MaxOpenReviews.Int32)
+		user.MaxOpenReviews = &limit
+	}
+	if u.WorkStart.Valid && u.WorkEnd.Valid {
+		user.WorkingHours = &models.WorkingHours{
+			Start: models.FormatClock(int(u.WorkStart.Int16)),
+			End:   models.FormatClock(int(u.WorkEnd.Int16)),
+		}
+	}
+	return user
 }
@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"database/sql"
+	"reflect"
 	"testing"
 
 	"github.com/437d5/pr-review-manager/internal/domain/models"
@@ -26,12 +28,47 @@ func TestUserDTO_ToDomain(t *testing.T) {
 				IsActive: true,
 				TeamName: "backend-team"},
 		},
+		{
+			name: "user with review limit",
+			user: User{
+				ID:             "user-2",
+				Username:       "User 2",
+				IsActive:       true,
+				MaxOpenReviews: sql.NullInt32{Int32: 3, Valid: true},
+				OpenReviews:    1,
+			},
+			expected: models.User{
+				ID:             "user-2",
+				Username:       "User 2",
+				IsActive:       true,
+				MaxOpenReviews: func() *int { limit := 3; return &limit }(),
+				OpenReviews:    1,
+			},
+		},
+		{
+			name: "user with working hours",
+			user: User{
+				ID:        "user-3",
+				Username:  "User 3",
+				IsActive:  true,
+				Timezone:  sql.NullString{String: "Europe/Moscow", Valid: true},
+				WorkStart: sql.NullInt16{Int16: 9 * 60, Valid: true},
+				WorkEnd:   sql.NullInt16{Int16: 18*60 + 30, Valid: true},
+			},
+			expected: models.User{
+				ID:           "user-3",
+				Username:     "User 3",
+				IsActive:     true,
+				Timezone:     "Europe/Moscow",
+				WorkingHours: &models.WorkingHours{Start: "09:00", End: "18:30"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			result := tt.user.ToDomain()
-			if result != tt.expected {
+			if !reflect.DeepEqual(result, tt.expected) {
 				t.Errorf("expected %+v, got %+v", tt.expected, result)
 			}
 		})
@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+)
+
+const (
+	HeaderEvent     = "X-Reviewer-Event"
+	HeaderDelivery  = "X-Reviewer-Delivery"
+	HeaderSignature = "X-Reviewer-Signature-256"
+)
+
+// Publisher posts outbox messages to every configured URL,
+// message is delivered only when all receivers accept it,
+// a retried message is posted only to the URLs that have not accepted it yet
+type Publisher struct {
+	client *http.Client
+	urls   []string
+	secret []byte
+}
+
+func NewPublisher(urls []string, secret string, timeout time.Duration) *Publisher {
+	return &Publisher{
+		client: &http.Client{Timeout: timeout},
+		urls:   urls,
+		secret: []byte(secret),
+	}
+}
+
+func (p *Publisher) Publish(ctx context.Context, message models.OutboxMessage) error {
+	var delivered []string
+	var errs []error
+	for _, url := range p.urls {
+		if slices.Contains(message.DeliveredTargets, url) {
+			continue
+		}
+		if err := p.post(ctx, url, message); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", url, err))
+			continue
+		}
+		delivered = append(delivered, url)
+	}
+
+	err := errors.Join(errs...)
+	if err != nil && len(delivered) > 0 {
+		return &models.PartialDeliveryError{Delivered: delivered, Err: err}
+	}
+	return err
+}
+
+func (p *Publisher) post(ctx context.Context, url string, message models.OutboxMessage) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(message.Payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, message.Topic)
+	// receivers dedupe retried deliveries by this id
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(message.ID, 10))
+	if len(p.secret) > 0 {
+		req.Header.Set(HeaderSignature, Sign(p.secret, message.Payload))
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns signature of the body in the form of "sha256=<hex HMAC>"
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,126 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublisher_Publish(t *testing.T) {
+	message := models.OutboxMessage{
+		ID:      42,
+		Topic:   models.TopicReviewerAssigned,
+		Payload: json.RawMessage(`{"type":"reviewer.assigned","pull_request_id":"pr-1","user_id":"u2"}`),
+	}
+
+	t.Run("signed delivery", func(t *testing.T) {
+		var received *http.Request
+		var body []byte
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer receiver.Close()
+
+		publisher := NewPublisher([]string{receiver.URL}, "secret", time.Second)
+
+		err := publisher.Publish(context.Background(), message)
+
+		require.NoError(t, err)
+		require.NotNil(t, received)
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.Equal(t, models.TopicReviewerAssigned, received.Header.Get(HeaderEvent))
+		assert.Equal(t, "42", received.Header.Get(HeaderDelivery))
+		assert.JSONEq(t, string(message.Payload), string(body))
+		assert.True(t, hmac.Equal(
+			[]byte(Sign([]byte("secret"), body)),
+			[]byte(received.Header.Get(HeaderSignature)),
+		))
+	})
+
+	t.Run("failed receiver", func(t *testing.T) {
+		var okCalls int
+		ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			okCalls++
+		}))
+		defer ok.Close()
+		failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer failing.Close()
+
+		publisher := NewPublisher([]string{ok.URL, failing.URL}, "secret", time.Second)
+
+		err := publisher.Publish(context.Background(), message)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unexpected status 503")
+		assert.Equal(t, 1, okCalls)
+
+		var partial *models.PartialDeliveryError
+		require.ErrorAs(t, err, &partial)
+		assert.Equal(t, []string{ok.URL}, partial.Delivered)
+	})
+
+	t.Run("retry skips delivered targets", func(t *testing.T) {
+		var deliveredCalls, pendingCalls int
+		delivered := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			deliveredCalls++
+		}))
+		defer delivered.Close()
+		pending := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			pendingCalls++
+		}))
+		defer pending.Close()
+
+		publisher := NewPublisher([]string{delivered.URL, pending.URL}, "secret", time.Second)
+		retried := message
+		retried.DeliveredTargets = []string{delivered.URL}
+
+		err := publisher.Publish(context.Background(), retried)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, deliveredCalls)
+		assert.Equal(t, 1, pendingCalls)
+	})
+
+	t.Run("all receivers failed", func(t *testing.T) {
+		failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer failing.Close()
+
+		publisher := NewPublisher([]string{failing.URL}, "secret", time.Second)
+
+		err := publisher.Publish(context.Background(), message)
+
+		require.Error(t, err)
+		var partial *models.PartialDeliveryError
+		assert.False(t, errors.As(err, &partial))
+	})
+
+	t.Run("no urls", func(t *testing.T) {
+		publisher := NewPublisher(nil, "", time.Second)
+
+		assert.NoError(t, publisher.Publish(context.Background(), message))
+	})
+}
+
+func TestSign(t *testing.T) {
+	// reference value from `echo -n 'hello' | openssl dgst -sha256 -hmac key`
+	assert.Equal(t,
+		"sha256=9307b3b915efb5171ff14d8cb55fbcc798c6c0ef1456d66ded1a6aa723a58b7b",
+		Sign([]byte("key"), []byte("hello")),
+	)
+}
@@ -14,6 +14,9 @@ type Config struct {
 	WriteTimeout int    `env:"REVIEWER_WRITE_TIMEOUT" envDefault:"15"`
 	IdleTimeout  int    `env:"REVIEWER_IDLE_TIMEOUT" envDefault:"60"`
 	DB           DBConfig
+	Webhook      WebhookConfig
+	CodeHost     CodeHostConfig
+	Absence      AbsenceConfig
 }
 
 type DBConfig struct {
@@ -24,6 +27,30 @@ type DBConfig struct {
 	Password string `env:"DB_PASSWORD" envDefault:"password"`
 }
 
+type WebhookConfig struct {
+	URLs         []string `env:"WEBHOOK_URLS"`
+	Secret       string   `env:"WEBHOOK_SECRET"`
+	Timeout      int      `env:"WEBHOOK_TIMEOUT" envDefault:"10"`
+	PollInterval int      `env:"WEBHOOK_POLL_INTERVAL" envDefault:"5"`
+	MaxAttempts  int      `env:"WEBHOOK_MAX_ATTEMPTS" envDefault:"10"`
+	// secret of incoming GitHub webhooks, they are rejected when it is empty
+	GitHubSecret string `env:"GITHUB_WEBHOOK_SECRET"`
+	// token of incoming GitLab webhooks, they are rejected when it is empty
+	GitLabToken string `env:"GITLAB_WEBHOOK_TOKEN"`
+}
+
+type CodeHostConfig struct {
+	GitHubAPIURL string `env:"GITHUB_API_URL" envDefault:"https://api.github.com"`
+	// assignments are not written back to GitHub when it is empty
+	GitHubToken string `env:"GITHUB_TOKEN"`
+	Timeout     int    `env:"CODE_HOST_TIMEOUT" envDefault:"10"`
+}
+
+type AbsenceConfig struct {
+	// how often started absences are checked, in seconds
+	PollInterval int `env:"ABSENCE_POLL_INTERVAL" envDefault:"60"`
+}
+
 func MustLoadConfig() *Config {
 	var cfg Config
 	err := env.Parse(&cfg)
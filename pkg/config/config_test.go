@@ -18,6 +18,12 @@ func TestMustLoadConfig_WithEnvOverrides(t *testing.T) {
 	t.Setenv("DB_PORT", "6543")
 	t.Setenv("DB_USER", "db-user")
 	t.Setenv("DB_PASSWORD", "db-pass")
+	t.Setenv("WEBHOOK_URLS", "http://a.local/hook,http://b.local/hook")
+	t.Setenv("WEBHOOK_SECRET", "hook-secret")
+	t.Setenv("WEBHOOK_MAX_ATTEMPTS", "3")
+	t.Setenv("GITHUB_WEBHOOK_SECRET", "gh-secret")
+	t.Setenv("GITLAB_WEBHOOK_TOKEN", "gl-token")
+	t.Setenv("GITHUB_TOKEN", "gh-token")
 
 	cfg := MustLoadConfig()
 
@@ -33,6 +39,20 @@ func TestMustLoadConfig_WithEnvOverrides(t *testing.T) {
 	assert.Equal(t, "6543", cfg.DB.Port)
 	assert.Equal(t, "db-user", cfg.DB.User)
 	assert.Equal(t, "db-pass", cfg.DB.Password)
+
+	assert.Equal(t, []string{"http://a.local/hook", "http://b.local/hook"}, cfg.Webhook.URLs)
+	assert.Equal(t, "hook-secret", cfg.Webhook.Secret)
+	assert.Equal(t, 3, cfg.Webhook.MaxAttempts)
+	assert.Equal(t, 10, cfg.Webhook.Timeout)
+	assert.Equal(t, 5, cfg.Webhook.PollInterval)
+	assert.Equal(t, "gh-secret", cfg.Webhook.GitHubSecret)
+	assert.Equal(t, "gl-token", cfg.Webhook.GitLabToken)
+
+	assert.Equal(t, "https://api.github.com", cfg.CodeHost.GitHubAPIURL)
+	assert.Equal(t, "gh-token", cfg.CodeHost.GitHubToken)
+	assert.Equal(t, 10, cfg.CodeHost.Timeout)
+
+	assert.Equal(t, 60, cfg.Absence.PollInterval)
 }
 
 func TestMustLoadConfig_InvalidEnvPanics(t *testing.T) {
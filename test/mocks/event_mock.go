@@ -0,0 +1,22 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockEventRepository struct {
+	mock.Mock
+}
+
+func (m *MockEventRepository) Append(ctx context.Context, events []models.AssignmentEvent) error {
+	args := m.Called(ctx, events)
+	return args.Error(0)
+}
+
+func (m *MockEventRepository) GetByPR(ctx context.Context, prID string) ([]models.AssignmentEvent, error) {
+	args := m.Called(ctx, prID)
+	return args.Get(0).([]models.AssignmentEvent), args.Error(1)
+}
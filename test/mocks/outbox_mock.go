@@ -0,0 +1,48 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) Add(ctx context.Context, messages []models.OutboxMessage) error {
+	args := m.Called(ctx, messages)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) Claim(ctx context.Context, consumer string, limit int, lease time.Duration) ([]models.OutboxMessage, error) {
+	args := m.Called(ctx, consumer, limit, lease)
+	return args.Get(0).([]models.OutboxMessage), args.Error(1)
+}
+
+func (m *MockOutboxRepository) MarkDelivered(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) MarkFailed(ctx context.Context, id int64, lastError string, delay time.Duration) error {
+	args := m.Called(ctx, id, lastError, delay)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) Postpone(ctx context.Context, id int64, lastError string, delay time.Duration) error {
+	args := m.Called(ctx, id, lastError, delay)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) AddDeliveredTargets(ctx context.Context, id int64, targets []string) error {
+	args := m.Called(ctx, id, targets)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) MarkDead(ctx context.Context, id int64, lastError string) error {
+	args := m.Called(ctx, id, lastError)
+	return args.Error(0)
+}
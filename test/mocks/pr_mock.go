@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/437d5/pr-review-manager/internal/domain/models"
 	"github.com/stretchr/testify/mock"
@@ -16,18 +17,73 @@ func (m *MockPRRepository) Create(ctx context.Context, pr models.PullRequest) (m
 	return args.Get(0).(models.PullRequest), args.Error(1)
 }
 
-func (m *MockPRRepository) Merge(ctx context.Context, id string) (models.PullRequest, error) {
-	args := m.Called(ctx, id)
+func (m *MockPRRepository) Merge(ctx context.Context, id string, force bool) (models.PullRequest, error) {
+	args := m.Called(ctx, id, force)
 	return args.Get(0).(models.PullRequest), args.Error(1)
 }
 
+func (m *MockPRRepository) SetStatus(ctx context.Context, id string, status models.PRStatus) (models.PullRequest, error) {
+	args := m.Called(ctx, id, status)
+	return args.Get(0).(models.PullRequest), args.Error(1)
+}
+
+func (m *MockPRRepository) AssignReviewers(ctx context.Context, prID string, reviewerIDs []string) error {
+	args := m.Called(ctx, prID, reviewerIDs)
+	return args.Error(0)
+}
+
+func (m *MockPRRepository) AssignRequestedReviewers(ctx context.Context, prID string, reviewerIDs []string) error {
+	args := m.Called(ctx, prID, reviewerIDs)
+	return args.Error(0)
+}
+
+func (m *MockPRRepository) MarkFallbackReviewers(ctx context.Context, prID string, reviewerIDs []string) error {
+	args := m.Called(ctx, prID, reviewerIDs)
+	return args.Error(0)
+}
+
+func (m *MockPRRepository) GetReviewerDetails(ctx context.Context, prID string) ([]models.Reviewer, error) {
+	args := m.Called(ctx, prID)
+	return args.Get(0).([]models.Reviewer), args.Error(1)
+}
+
+func (m *MockPRRepository) List(ctx context.Context, filter models.PRFilter) (models.PRPage, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(models.PRPage), args.Error(1)
+}
+
 func (m *MockPRRepository) Reassign(ctx context.Context, prID, oldReviewerID, newReviewerID string) (models.PullRequest, error) {
 	args := m.Called(ctx, prID, oldReviewerID, newReviewerID)
 	return args.Get(0).(models.PullRequest), args.Error(1)
 }
 
-func (m *MockPRRepository) GetPRs(ctx context.Context, userID string) ([]models.PullRequest, error) {
-	args := m.Called(ctx, userID)
+func (m *MockPRRepository) AddReviewer(ctx context.Context, prID, reviewerID string) (models.PullRequest, error) {
+	args := m.Called(ctx, prID, reviewerID)
+	return args.Get(0).(models.PullRequest), args.Error(1)
+}
+
+func (m *MockPRRepository) RemoveReviewer(ctx context.Context, prID, reviewerID string) (models.PullRequest, error) {
+	args := m.Called(ctx, prID, reviewerID)
+	return args.Get(0).(models.PullRequest), args.Error(1)
+}
+
+func (m *MockPRRepository) ReassignBulk(ctx context.Context, reassignments []models.ReviewReassignment) error {
+	args := m.Called(ctx, reassignments)
+	return args.Error(0)
+}
+
+func (m *MockPRRepository) GetPRs(ctx context.Context, userID string, filter models.PRFilter) (models.PRPage, error) {
+	args := m.Called(ctx, userID, filter)
+	return args.Get(0).(models.PRPage), args.Error(1)
+}
+
+func (m *MockPRRepository) CountPRs(ctx context.Context, filter models.PRFilter) (int, error) {
+	args := m.Called(ctx, filter)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockPRRepository) GetOpenByReviewers(ctx context.Context, reviewerIDs []string) ([]models.PullRequest, error) {
+	args := m.Called(ctx, reviewerIDs)
 	return args.Get(0).([]models.PullRequest), args.Error(1)
 }
 
@@ -44,6 +100,21 @@ func (m *MockPRRepository) GetReviewers(ctx context.Context, prID string) ([]mod
 	return args.Get(0).([]models.User), args.Error(1)
 }
 
+func (m *MockPRRepository) SubmitReview(ctx context.Context, prID, reviewerID string, decision models.ReviewDecision) (models.PullRequest, error) {
+	args := m.Called(ctx, prID, reviewerID, decision)
+	return args.Get(0).(models.PullRequest), args.Error(1)
+}
+
+func (m *MockPRRepository) CountOpenReviews(ctx context.Context, userIDs []string) (map[string]int, error) {
+	args := m.Called(ctx, userIDs)
+	return args.Get(0).(map[string]int), args.Error(1)
+}
+
+func (m *MockPRRepository) GetLastAssignedAt(ctx context.Context, userIDs []string) (map[string]time.Time, error) {
+	args := m.Called(ctx, userIDs)
+	return args.Get(0).(map[string]time.Time), args.Error(1)
+}
+
 func (m *MockPRRepository) Delete(ctx context.Context, id string) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -0,0 +1,27 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/437d5/pr-review-manager/internal/domain/models"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockStatsRepository struct {
+	mock.Mock
+}
+
+func (m *MockStatsRepository) GetReviewerStats(ctx context.Context, filter models.StatsFilter) ([]models.ReviewerStats, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]models.ReviewerStats), args.Error(1)
+}
+
+func (m *MockStatsRepository) GetTeamPRStats(ctx context.Context, teamName string) (models.TeamStats, error) {
+	args := m.Called(ctx, teamName)
+	return args.Get(0).(models.TeamStats), args.Error(1)
+}
+
+func (m *MockStatsRepository) GetMergedPerWeek(ctx context.Context, teamName string, weeks int) ([]models.WeeklyCount, error) {
+	args := m.Called(ctx, teamName, weeks)
+	return args.Get(0).([]models.WeeklyCount), args.Error(1)
+}
@@ -25,3 +25,28 @@ func (m *MockTeamRepository) Exists(ctx context.Context, name string) (bool, err
 	args := m.Called(ctx, name)
 	return args.Bool(0), args.Error(1)
 }
+
+func (m *MockTeamRepository) GetSettings(ctx context.Context, name string) (models.TeamSettings, error) {
+	args := m.Called(ctx, name)
+	return args.Get(0).(models.TeamSettings), args.Error(1)
+}
+
+func (m *MockTeamRepository) GetOwnershipRules(ctx context.Context, name string) ([]models.OwnershipRule, error) {
+	args := m.Called(ctx, name)
+	return args.Get(0).([]models.OwnershipRule), args.Error(1)
+}
+
+func (m *MockTeamRepository) ReplaceOwnershipRules(ctx context.Context, name string, rules []models.OwnershipRule) error {
+	args := m.Called(ctx, name, rules)
+	return args.Error(0)
+}
+
+func (m *MockTeamRepository) UpdateSettings(ctx context.Context, name string, settings models.TeamSettings) (models.TeamSettings, error) {
+	args := m.Called(ctx, name, settings)
+	return args.Get(0).(models.TeamSettings), args.Error(1)
+}
+
+func (m *MockTeamRepository) ReplacePartners(ctx context.Context, name string, partners []string) error {
+	args := m.Called(ctx, name, partners)
+	return args.Error(0)
+}
@@ -26,6 +26,21 @@ func (m *MockUnitOfWork) PR() repositories.PullRequestRepository {
 	return args.Get(0).(repositories.PullRequestRepository)
 }
 
+func (m *MockUnitOfWork) Stats() repositories.StatsRepository {
+	args := m.Called()
+	return args.Get(0).(repositories.StatsRepository)
+}
+
+func (m *MockUnitOfWork) Events() repositories.EventRepository {
+	args := m.Called()
+	return args.Get(0).(repositories.EventRepository)
+}
+
+func (m *MockUnitOfWork) Outbox() repositories.OutboxRepository {
+	args := m.Called()
+	return args.Get(0).(repositories.OutboxRepository)
+}
+
 func (m *MockUnitOfWork) Begin(ctx context.Context) error {
 	args := m.Called(ctx)
 	return args.Error(0)
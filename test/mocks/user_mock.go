@@ -31,7 +31,47 @@ func (m *MockUserRepository) SetIsActive(ctx context.Context, userID string, isA
 	return args.Get(0).(models.User), args.Error(1)
 }
 
+func (m *MockUserRepository) SetMaxOpenReviews(ctx context.Context, id string, limit *int) (models.User, error) {
+	args := m.Called(ctx, id, limit)
+	return args.Get(0).(models.User), args.Error(1)
+}
+
+func (m *MockUserRepository) SetWorkingHours(ctx context.Context, id string, timezone string, hours *models.WorkingHours) (models.User, error) {
+	args := m.Called(ctx, id, timezone, hours)
+	return args.Get(0).(models.User), args.Error(1)
+}
+
+func (m *MockUserRepository) SetTeamIsActive(ctx context.Context, teamName string, userIDs []string, isActive bool) ([]models.User, error) {
+	args := m.Called(ctx, teamName, userIDs, isActive)
+	return args.Get(0).([]models.User), args.Error(1)
+}
+
 func (m *MockUserRepository) GetActiveTeammatesByUserID(ctx context.Context, userID string) ([]models.User, error) {
 	args := m.Called(ctx, userID)
 	return args.Get(0).([]models.User), args.Error(1)
 }
+
+func (m *MockUserRepository) GetActivePartnersByUserID(ctx context.Context, userID string) ([]models.User, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]models.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetActiveMembersByTeamName(ctx context.Context, teamName string) ([]models.User, error) {
+	args := m.Called(ctx, teamName)
+	return args.Get(0).([]models.User), args.Error(1)
+}
+
+func (m *MockUserRepository) ReplaceAbsences(ctx context.Context, userID string, absences []models.Absence) ([]models.Absence, error) {
+	args := m.Called(ctx, userID, absences)
+	return args.Get(0).([]models.Absence), args.Error(1)
+}
+
+func (m *MockUserRepository) GetStartedAbsences(ctx context.Context, limit int) ([]models.Absence, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]models.Absence), args.Error(1)
+}
+
+func (m *MockUserRepository) MarkAbsenceReassigned(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}